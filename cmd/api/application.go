@@ -3,9 +3,13 @@ package main
 import (
 	"time"
 
+	"github.com/wit-id/blueprint-backend-go/common/constants"
 	"github.com/wit-id/blueprint-backend-go/common/echohttp"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	jobWorker "github.com/wit-id/blueprint-backend-go/src/job/worker"
 	"github.com/wit-id/blueprint-backend-go/toolkit/db/postgres"
+	"github.com/wit-id/blueprint-backend-go/toolkit/fcm"
 	"github.com/wit-id/blueprint-backend-go/toolkit/log"
 	"github.com/wit-id/blueprint-backend-go/toolkit/runtimekit"
 
@@ -56,6 +60,23 @@ func main() {
 	// setup service
 	svc := httpservice.NewService(mainDB, appConfig)
 
+	// run queued and scheduled jobs in background, for deployments without a cmd/job worker
+	if appConfig.GetBool(constants.ConfigJobWorkerEnabled) {
+		// push notifications only go out from the worker, a bad fcm setup must not stop the api
+		fcmSender, errFcm := fcm.NewFromConfig(appConfig, "fcm")
+		if errFcm != nil {
+			log.FromCtx(appContext).Error(errFcm, "failed setup fcm sender, messages are only logged")
+			fcmSender = fcm.NewLogSender()
+		}
+
+		jobSvc := jobService.NewJobService(mainDB, appConfig)
+		jobHandler := jobWorker.NewJobHandler(mainDB, appConfig, fcmSender)
+
+		runtimekit.ExecuteBackground(func() {
+			jobSvc.RunJobWorker(appContext, jobHandler)
+		})
+	}
+
 	// expose echo http server
 	echohttp.RunEchoHTTPService(appContext, svc, appConfig)
 }
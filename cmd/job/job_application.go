@@ -1,7 +1,111 @@
 package main
 
-import "log"
+import (
+	"os"
+	"time"
+
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	jobWorker "github.com/wit-id/blueprint-backend-go/src/job/worker"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/db/postgres"
+	"github.com/wit-id/blueprint-backend-go/toolkit/fcm"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"github.com/wit-id/blueprint-backend-go/toolkit/runtimekit"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+var errUnknownJob = errors.New("unknown job")
+
+// commandWorker runs queued and scheduled jobs until the process is stopped. Any other argument
+// is a job type run once right away, for deployments that schedule it externally.
+const commandWorker = "worker"
 
 func main() {
-	log.Println("running one time job #1")
+	var err error
+
+	setDefaultTimezone()
+
+	appContext, cancel := runtimekit.NewRuntimeContext()
+	defer func() {
+		cancel()
+
+		if err != nil {
+			log.FromCtx(appContext).Error(err, "found error")
+		}
+	}()
+
+	if len(os.Args) < 2 {
+		err = errors.Wrap(errUnknownJob, "usage: job worker | job <job type>")
+		return
+	}
+
+	// Set config file (env)
+	appConfig, err := envConfigVariable("config.yaml")
+	if err != nil {
+		return
+	}
+
+	// setup db
+	mainDB, err := postgres.NewFromConfig(appConfig, "db")
+	if err != nil {
+		return
+	}
+
+	// setup logging
+	logger, err := log.NewFromConfig(appConfig, "log")
+	if err != nil {
+		return
+	}
+
+	logger.Set()
+
+	// setup push notification sender
+	fcmSender, err := fcm.NewFromConfig(appConfig, "fcm")
+	if err != nil {
+		return
+	}
+
+	jobHandler := jobWorker.NewJobHandler(mainDB, appConfig, fcmSender)
+
+	if os.Args[1] == commandWorker {
+		jobService.NewJobService(mainDB, appConfig).RunJobWorker(appContext, jobHandler)
+
+		return
+	}
+
+	// an export file job needs the payload it was enqueued with
+	handler, ok := jobHandler[os.Args[1]]
+	if !ok || os.Args[1] == constants.JobTypeExportFile {
+		err = errors.Wrapf(errUnknownJob, "job=%s", os.Args[1])
+		return
+	}
+
+	log.FromCtx(appContext).Info("running job", "job", os.Args[1])
+
+	err = handler(appContext, sqlc.Job{JobType: os.Args[1], Payload: []byte("{}")})
+}
+
+func setDefaultTimezone() {
+	loc, err := time.LoadLocation("UTC")
+	if err != nil {
+		loc = time.Now().Location()
+	}
+
+	time.Local = loc
+}
+
+func envConfigVariable(filePath string) (cfg *viper.Viper, err error) {
+	cfg = viper.New()
+	cfg.SetConfigFile(filePath)
+
+	if err = cfg.ReadInConfig(); err != nil {
+		err = errors.Wrap(err, "Error while reading config file")
+
+		return
+	}
+
+	return
 }
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	AccessView     = "view"
 	AccessCreate   = "create"
@@ -20,4 +22,154 @@ const (
 
 	StatusActive   = "active"
 	StatusInactive = "inactive"
+
+	ProductHistoryTypeMasuk  = "masuk"
+	ProductHistoryTypeKeluar = "keluar"
+
+	ProductHistoryReferencePurchaseOrder = "purchase_order"
+	ProductHistoryReferenceStockTransfer = "stock_transfer"
+	ProductHistoryReferenceStockOpname   = "stock_opname"
+	ProductHistoryReferenceSalesOrder    = "sales_order"
+	ProductHistoryReferenceStockReturn   = "stock_return"
+	ProductHistoryReferenceAssembly      = "product_assembly"
+
+	StockTransferStatusDraft     = "draft"
+	StockTransferStatusInTransit = "in_transit"
+	StockTransferStatusReceived  = "received"
+	StockTransferStatusCancelled = "cancelled"
+	StockTransferCodePrefix      = "TRF"
+
+	StockOpnameStatusOpen      = "open"
+	StockOpnameStatusApproved  = "approved"
+	StockOpnameStatusCancelled = "cancelled"
+	StockOpnameCodePrefix      = "OPN"
+
+	PurchaseOrderStatusOpen              = "open"
+	PurchaseOrderStatusPartiallyReceived = "partially_received"
+	PurchaseOrderStatusClosed            = "closed"
+	PurchaseOrderCodePrefix              = "PO"
+
+	SalesOrderStatusOpen             = "open"
+	SalesOrderStatusPartiallyShipped = "partially_shipped"
+	SalesOrderStatusClosed           = "closed"
+	SalesOrderStatusCancelled        = "cancelled"
+	SalesOrderCodePrefix             = "SO"
+
+	StockReturnStatusRequested = "requested"
+	StockReturnStatusReceived  = "received"
+	StockReturnStatusInspected = "inspected"
+	StockReturnStatusCancelled = "cancelled"
+	StockReturnCodePrefix      = "RMA"
+
+	StockReturnDispositionRestock    = "restock"
+	StockReturnDispositionQuarantine = "quarantine"
+	StockReturnDispositionScrap      = "scrap"
+
+	StockReturnReasonDamaged        = "damaged"
+	StockReturnReasonDefective      = "defective"
+	StockReturnReasonWrongItem      = "wrong_item"
+	StockReturnReasonNotAsDescribed = "not_as_described"
+	StockReturnReasonNoLongerNeeded = "no_longer_needed"
+	StockReturnReasonOther          = "other"
+
+	StockWriteOffReasonDamaged      = "damaged"
+	StockWriteOffReasonDefective    = "defective"
+	StockWriteOffReasonExpired      = "expired"
+	StockWriteOffReasonContaminated = "contaminated"
+	StockWriteOffReasonOther        = "other"
+
+	StockStatusAvailable  = "available"
+	StockStatusQuarantine = "quarantine"
+	StockStatusDamaged    = "damaged"
+	StockStatusOnHold     = "on_hold"
+
+	StockStatusReasonInspection  = "inspection"
+	StockStatusReasonDamaged     = "damaged"
+	StockStatusReasonQualityHold = "quality_hold"
+	StockStatusReasonRecall      = "recall"
+	StockStatusReasonReleased    = "released"
+	StockStatusReasonOther       = "other"
+
+	WarehouseLocationTypeZone = "zone"
+	WarehouseLocationTypeRack = "rack"
+	WarehouseLocationTypeBin  = "bin"
+
+	ProductSerialStatusInStock = "in_stock"
+	ProductSerialStatusOut     = "out"
+
+	ProductBaseUnitDefault = "pcs"
+
+	ProductCostingMethodFIFO    = "fifo"
+	ProductCostingMethodAverage = "average"
+
+	ProductAssemblyOperationAssemble    = "assemble"
+	ProductAssemblyOperationDisassemble = "disassemble"
+	ProductAssemblyCodePrefix           = "ASM"
+
+	ProductBarcodeTypeEAN13   = "ean13"
+	ProductBarcodeTypeUPC     = "upc"
+	ProductBarcodeTypeCode128 = "code128"
+	ProductBarcodeTypeQR      = "qr"
+
+	LabelFormatPNG        = "png"
+	LabelFormatSVG        = "svg"
+	LabelSheetMaxProducts = 500
+
+	ImportFormatCSV  = "csv"
+	ImportFormatXLSX = "xlsx"
+	ImportMaxRows    = 5000
+	ImportListSep    = "|" // separates the items of a list column, e.g. category_ids
+
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+	ExportFormatPDF  = "pdf"
+
+	ExportTypeProduct        = "product"
+	ExportTypeWarehouse      = "warehouse"
+	ExportTypeUserBackoffice = "user_backoffice"
+	ExportTypeUserHandheld   = "user_handheld"
+	ExportTypeProductHistory = "product_history"
+
+	ExportStatusPending   = "pending"
+	ExportStatusCompleted = "completed"
+	ExportStatusFailed    = "failed"
+
+	ExportSyncMaxRows = 1000 // larger exports are rendered in the background
+	ExportPageSize    = 500  // rows read from the database at a time
+
+	StockAlertTypeLowStock       = "low_stock"
+	StockAlertStatusOpen         = "open"
+	StockAlertStatusAcknowledged = "acknowledged"
+	StockAlertStatusResolved     = "resolved"
+
+	StockReservationStatusActive    = "active"
+	StockReservationStatusFulfilled = "fulfilled"
+	StockReservationStatusReleased  = "released"
+	StockReservationStatusExpired   = "expired"
+
+	StockReservationReferenceSalesOrder = "sales_order"
+
+	ConfigStockReservationTTL = "stock-reservation.ttl"
+
+	JobTypeExportFile             = "export-file"
+	JobTypeReorderPoint           = "reorder-point"
+	JobTypeStockReservationExpiry = "stock-reservation-expiry"
+	JobTypeStockSnapshot          = "stock-snapshot"
+
+	JobStatusPending   = "pending"
+	JobStatusRunning   = "running"
+	JobStatusCompleted = "completed"
+	JobStatusDead      = "dead" // ran out of attempts, waits for a manual retry
+	JobStatusCancelled = "cancelled"
+
+	JobMaxAttempts    = 5
+	JobRetryBaseDelay = 30 * time.Second // doubled on every failed attempt
+	JobRetryMaxDelay  = time.Hour
+	JobPollInterval   = 5 * time.Second  // default of job.poll-interval
+	JobLockTimeout    = 30 * time.Minute // default of job.lock-timeout, a run missing heartbeats this long is requeued
+
+	ConfigJobPollInterval  = "job.poll-interval"
+	ConfigJobLockTimeout   = "job.lock-timeout"
+	ConfigJobSchedule      = "job.schedule"
+	ConfigJobWorkerEnabled = "job.worker-enabled"
 )
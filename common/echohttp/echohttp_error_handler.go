@@ -30,9 +30,12 @@ func handleEchoError(_ config.KVStore) echo.HTTPErrorHandler {
 		case errors.Is(err, httpservice.ErrInvalidAppKey) || errors.Is(err, httpservice.ErrInvalidOTP) || errors.Is(err, httpservice.ErrUnauthorizedUser) || errors.Is(err, httpservice.ErrInActiveUser) || errors.Is(err, httpservice.ErrUnauthorizedTokenData):
 			statusCode = http.StatusUnauthorized
 			message = err.Error()
-		case errors.Is(err, httpservice.ErrUserNotFound):
+		case errors.Is(err, httpservice.ErrUserNotFound) || errors.Is(err, httpservice.ErrProductNotFound) || errors.Is(err, httpservice.ErrProductCategoryNotFound) || errors.Is(err, httpservice.ErrWarehouseNotFound) || errors.Is(err, httpservice.ErrProductHistoryNotFound) || errors.Is(err, httpservice.ErrStockTransferNotFound) || errors.Is(err, httpservice.ErrStockOpnameNotFound) || errors.Is(err, httpservice.ErrPurchaseOrderNotFound) || errors.Is(err, httpservice.ErrSalesOrderNotFound) || errors.Is(err, httpservice.ErrWarehouseLocationNotFound) || errors.Is(err, httpservice.ErrProductSerialNotFound) || errors.Is(err, httpservice.ErrProductBarcodeNotFound) || errors.Is(err, httpservice.ErrStockReorderPointNotFound) || errors.Is(err, httpservice.ErrStockAlertNotFound) || errors.Is(err, httpservice.ErrSupplierNotFound) || errors.Is(err, httpservice.ErrProductSupplierNotFound) || errors.Is(err, httpservice.ErrCustomerNotFound) || errors.Is(err, httpservice.ErrCustomerAddressNotFound) || errors.Is(err, httpservice.ErrStockReturnNotFound) || errors.Is(err, httpservice.ErrStockStatusMoveNotFound) || errors.Is(err, httpservice.ErrProductAssemblyNotFound) || errors.Is(err, httpservice.ErrExportFileNotFound) || errors.Is(err, httpservice.ErrJobNotFound):
 			statusCode = http.StatusNotFound
 			message = err.Error()
+		case errors.Is(err, httpservice.ErrInsufficientStock) || errors.Is(err, httpservice.ErrInvalidStockTransfer) || errors.Is(err, httpservice.ErrInvalidStockOpname) || errors.Is(err, httpservice.ErrPurchaseOrderClosed) || errors.Is(err, httpservice.ErrSalesOrderClosed) || errors.Is(err, httpservice.ErrWarehouseLocationInUse) || errors.Is(err, httpservice.ErrDuplicateProductSerial) || errors.Is(err, httpservice.ErrProductHasStock) || errors.Is(err, httpservice.ErrProductCategoryInUse) || errors.Is(err, httpservice.ErrDuplicateProductSku) || errors.Is(err, httpservice.ErrDuplicateProductBarcode) || errors.Is(err, httpservice.ErrStockAlertClosed) || errors.Is(err, httpservice.ErrDuplicateSupplierCode) || errors.Is(err, httpservice.ErrDuplicateCustomerCode) || errors.Is(err, httpservice.ErrInvalidStockReturn) || errors.Is(err, httpservice.ErrDuplicateWarehouseCode) || errors.Is(err, httpservice.ErrExportFileNotReady) || errors.Is(err, httpservice.ErrJobNotRetryable) || errors.Is(err, httpservice.ErrJobNotCancellable):
+			statusCode = http.StatusConflict
+			message = err.Error()
 		case errors.Is(err, httpservice.ErrNoResultData):
 			statusCode = http.StatusOK
 			message = err.Error()
@@ -2,8 +2,10 @@ package echohttp
 
 import (
 	"context"
+	labelApp "github.com/wit-id/blueprint-backend-go/src/label/application"
 	productHandledApp "github.com/wit-id/blueprint-backend-go/src/product/product/application"
 	productCategoryHandledApp "github.com/wit-id/blueprint-backend-go/src/product/product_category/application"
+	productSerialApp "github.com/wit-id/blueprint-backend-go/src/product/product_serial/application"
 	"net/http"
 
 	"github.com/wit-id/blueprint-backend-go/common/constants"
@@ -24,6 +26,21 @@ import (
 	userHandheldApp "github.com/wit-id/blueprint-backend-go/src/user_handheld/application"
 
 	warehouseHandledApp "github.com/wit-id/blueprint-backend-go/src/warehouse/application"
+
+	customerApp "github.com/wit-id/blueprint-backend-go/src/customer/application"
+	exportApp "github.com/wit-id/blueprint-backend-go/src/export/application"
+	jobApp "github.com/wit-id/blueprint-backend-go/src/job/application"
+	productHistoryApp "github.com/wit-id/blueprint-backend-go/src/product_history/application"
+	purchaseOrderApp "github.com/wit-id/blueprint-backend-go/src/purchase_order/application"
+	salesOrderApp "github.com/wit-id/blueprint-backend-go/src/sales_order/application"
+	stockAlertApp "github.com/wit-id/blueprint-backend-go/src/stock_alert/application"
+	stockOpnameApp "github.com/wit-id/blueprint-backend-go/src/stock_opname/application"
+	stockReservationApp "github.com/wit-id/blueprint-backend-go/src/stock_reservation/application"
+	stockReturnApp "github.com/wit-id/blueprint-backend-go/src/stock_return/application"
+	stockStatusApp "github.com/wit-id/blueprint-backend-go/src/stock_status/application"
+	stockTransferApp "github.com/wit-id/blueprint-backend-go/src/stock_transfer/application"
+	stockValuationApp "github.com/wit-id/blueprint-backend-go/src/stock_valuation/application"
+	supplierApp "github.com/wit-id/blueprint-backend-go/src/supplier/application"
 )
 
 func RunEchoHTTPService(ctx context.Context, s *httpservice.Service, cfg config.KVStore) {
@@ -57,10 +74,57 @@ func RunEchoHTTPService(ctx context.Context, s *httpservice.Service, cfg config.
 	productHandledApp.AddRouteProduct(s, cfg, e)
 	// Product Category
 	productCategoryHandledApp.AddRouteProductCategory(s, cfg, e)
+	// Product Serial (serialized units)
+	productSerialApp.AddRouteProductSerial(s, cfg, e)
 
 	// Warehouse
 	warehouseHandledApp.AddRouteWarehouse(s, cfg, e)
 
+	// Label (barcode and QR printing)
+	labelApp.AddRouteLabel(s, cfg, e)
+
+	// Product History (stock movement)
+	productHistoryApp.AddRouteProductHistory(s, cfg, e)
+
+	// Stock Transfer (inter-warehouse)
+	stockTransferApp.AddRouteStockTransfer(s, cfg, e)
+
+	// Stock Opname (cycle count)
+	stockOpnameApp.AddRouteStockOpname(s, cfg, e)
+
+	// Purchase Order (goods receipt)
+	purchaseOrderApp.AddRoutePurchaseOrder(s, cfg, e)
+
+	// Sales Order (picking and dispatch)
+	salesOrderApp.AddRouteSalesOrder(s, cfg, e)
+
+	// Stock Alert (reorder points and low stock alerts)
+	stockAlertApp.AddRouteStockAlert(s, cfg, e)
+
+	// Stock Reservation (allocation for pending orders)
+	stockReservationApp.AddRouteStockReservation(s, cfg, e)
+
+	// Stock Valuation (inventory value and cost of goods sold)
+	stockValuationApp.AddRouteStockValuation(s, cfg, e)
+
+	// Supplier (supplier master data and product sourcing)
+	supplierApp.AddRouteSupplier(s, cfg, e)
+
+	// Customer (customer master data and shipping addresses)
+	customerApp.AddRouteCustomer(s, cfg, e)
+
+	// Stock Return (customer returns and inspection)
+	stockReturnApp.AddRouteStockReturn(s, cfg, e)
+
+	// Stock Status (available, quarantine, damaged and on hold stock)
+	stockStatusApp.AddRouteStockStatus(s, cfg, e)
+
+	// Export (files of list exports rendered in the background)
+	exportApp.AddRouteExport(s, cfg, e)
+
+	// Job (background job queue, retries and schedules)
+	jobApp.AddRouteJob(s, cfg, e)
+
 	// set config routes for role access
 	httpservice.SetRouteConfig(ctx, s, cfg, e)
 
@@ -23,9 +23,47 @@ var (
 	ErrUnauthorizedUser      = errors.New("unauthorized user")
 	ErrInActiveUser          = errors.New("user not active")
 
-	ErrProductNotFound         = errors.New("product not found")
-	ErrWarehouseNotFound       = errors.New("warehouse not found")
-	ErrProductCategoryNotFound = errors.New("product category not found")
+	ErrProductNotFound           = errors.New("product not found")
+	ErrWarehouseNotFound         = errors.New("warehouse not found")
+	ErrProductCategoryNotFound   = errors.New("product category not found")
+	ErrProductHistoryNotFound    = errors.New("product history not found")
+	ErrInsufficientStock         = errors.New("insufficient stock")
+	ErrStockTransferNotFound     = errors.New("stock transfer not found")
+	ErrInvalidStockTransfer      = errors.New("stock transfer status does not allow this action")
+	ErrStockOpnameNotFound       = errors.New("stock opname not found")
+	ErrInvalidStockOpname        = errors.New("stock opname is not open")
+	ErrPurchaseOrderNotFound     = errors.New("purchase order not found")
+	ErrPurchaseOrderClosed       = errors.New("purchase order is already closed")
+	ErrSalesOrderNotFound        = errors.New("sales order not found")
+	ErrSalesOrderClosed          = errors.New("sales order is already closed")
+	ErrWarehouseLocationNotFound = errors.New("warehouse location not found")
+	ErrWarehouseLocationInUse    = errors.New("warehouse location still has active children or stock")
+	ErrProductSerialNotFound     = errors.New("product serial not found")
+	ErrDuplicateProductSerial    = errors.New("product serial is already in stock")
+	ErrProductHasStock           = errors.New("product still has stock")
+	ErrProductCategoryInUse      = errors.New("product category still has active children or products")
+	ErrDuplicateProductSku       = errors.New("product sku is already used")
+	ErrDuplicateProductBarcode   = errors.New("product barcode is already used")
+	ErrProductBarcodeNotFound    = errors.New("product barcode not found")
+	ErrStockReorderPointNotFound = errors.New("stock reorder point not found")
+	ErrStockAlertNotFound        = errors.New("stock alert not found")
+	ErrStockAlertClosed          = errors.New("stock alert is not open")
+	ErrSupplierNotFound          = errors.New("supplier not found")
+	ErrDuplicateSupplierCode     = errors.New("supplier code is already used")
+	ErrProductSupplierNotFound   = errors.New("product is not linked to the supplier")
+	ErrCustomerNotFound          = errors.New("customer not found")
+	ErrDuplicateCustomerCode     = errors.New("customer code is already used")
+	ErrCustomerAddressNotFound   = errors.New("customer address not found")
+	ErrStockReturnNotFound       = errors.New("stock return not found")
+	ErrInvalidStockReturn        = errors.New("stock return status does not allow this action")
+	ErrStockStatusMoveNotFound   = errors.New("stock status move not found")
+	ErrProductAssemblyNotFound   = errors.New("product assembly not found")
+	ErrDuplicateWarehouseCode    = errors.New("warehouse code is already used")
+	ErrExportFileNotFound        = errors.New("export file not found")
+	ErrExportFileNotReady        = errors.New("export file is not ready")
+	ErrJobNotFound               = errors.New("job not found")
+	ErrJobNotRetryable           = errors.New("job cannot be retried")
+	ErrJobNotCancellable         = errors.New("job cannot be cancelled")
 
 	ErrRoleNotFound = errors.New("role not found")
 
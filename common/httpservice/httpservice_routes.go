@@ -44,7 +44,7 @@ func SetRouteConfig(ctx context.Context, svc *Service, cfg config.KVStore, e *ec
 	for v := range routesMap {
 		if routesMap[v].Method == "HEAD" {
 			routesAccess = append(routesAccess, RouteAccess{
-				Access: constants.AccessView + "|" + constants.AccessCreate + "|" + constants.AccessUpdate + "|" + constants.AccessGet + "|" + constants.AccessList + "|" + constants.AccessDelete,
+				Access: constants.AccessView + "|" + constants.AccessCreate + "|" + constants.AccessUpdate + "|" + constants.AccessGet + "|" + constants.AccessList + "|" + constants.AccessDelete + "|" + constants.AccessApproval,
 				Path:   routesMap[v].Path,
 			})
 		}
@@ -0,0 +1,34 @@
+package utility
+
+// GTINCheckDigit computes the GS1 check digit for the given digits without their check
+// digit, e.g. the first 12 digits of an EAN-13.
+func GTINCheckDigit(digits string) int {
+	sum := 0
+
+	// Weights alternate 3, 1 starting from the rightmost digit
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 3
+		}
+
+		sum += digit
+	}
+
+	return (10 - sum%10) % 10
+}
+
+// IsValidGTIN reports whether code has the given length, only digits and a valid check digit.
+func IsValidGTIN(code string, length int) bool {
+	if len(code) != length {
+		return false
+	}
+
+	for i := range code {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+	}
+
+	return GTINCheckDigit(code[:length-1]) == int(code[length-1]-'0')
+}
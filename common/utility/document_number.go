@@ -0,0 +1,14 @@
+package utility
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+)
+
+// GenerateDocumentNumber builds a human readable document number, e.g. TRF-20220501-X7K2QD.
+func GenerateDocumentNumber(prefix string) string {
+	return fmt.Sprintf("%s-%s-%s", prefix, time.Now().UTC().Format("20060102"), strings.ToUpper(shortuuid.New()[:6]))
+}
@@ -0,0 +1,229 @@
+package utility
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportWriter renders the rows of an export into a file. Rows are written as they come, but
+// a xlsx or pdf file only reaches the underlying writer on Close.
+type ExportWriter interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+// NewExportWriter starts an export file with its header row, title is shown on top of a pdf.
+func NewExportWriter(format string, title string, header []string, w io.Writer) (writer ExportWriter, err error) {
+	switch format {
+	case constants.ExportFormatCSV:
+		writer = &csvExportWriter{writer: csv.NewWriter(w)}
+	case constants.ExportFormatXLSX:
+		writer, err = newXlsxExportWriter(w)
+	case constants.ExportFormatPDF:
+		writer = newPdfExportWriter(title, header, w)
+
+		return
+	default:
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid export format %q", format)
+		return
+	}
+
+	if err != nil {
+		return
+	}
+
+	err = writer.WriteRow(header)
+
+	return
+}
+
+// WriteExportRows writes a page of rows through the writer.
+func WriteExportRows(writer ExportWriter, rows [][]string) (err error) {
+	for i := range rows {
+		if err = writer.WriteRow(rows[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// ExportContentType returns the mime type of an export format.
+func ExportContentType(format string) string {
+	switch format {
+	case constants.ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case constants.ExportFormatPDF:
+		return "application/pdf"
+	default:
+		return "text/csv"
+	}
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func (e *csvExportWriter) WriteRow(row []string) error {
+	values := make([]string, len(row))
+	for i := range row {
+		values[i] = escapeExportCell(row[i])
+	}
+
+	return e.writer.Write(values)
+}
+
+func (e *csvExportWriter) Close() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// escapeExportCell keeps a spreadsheet from running a value as a formula by prefixing it with a
+// quote. Plain numbers are left alone, a negative quantity is not a formula.
+func escapeExportCell(value string) string {
+	if value == "" {
+		return value
+	}
+
+	switch value[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return value
+		}
+
+		return "'" + value
+	}
+
+	return value
+}
+
+type xlsxExportWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+	w      io.Writer
+}
+
+func newXlsxExportWriter(w io.Writer) (writer *xlsxExportWriter, err error) {
+	file := excelize.NewFile()
+
+	stream, err := file.NewStreamWriter(file.GetSheetName(0))
+	if err != nil {
+		return
+	}
+
+	writer = &xlsxExportWriter{file: file, stream: stream, w: w}
+
+	return
+}
+
+func (e *xlsxExportWriter) WriteRow(row []string) (err error) {
+	e.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return
+	}
+
+	values := make([]interface{}, len(row))
+	for i := range row {
+		values[i] = escapeExportCell(row[i])
+	}
+
+	return e.stream.SetRow(cell, values)
+}
+
+func (e *xlsxExportWriter) Close() (err error) {
+	defer e.file.Close()
+
+	if err = e.stream.Flush(); err != nil {
+		return
+	}
+
+	return e.file.Write(e.w)
+}
+
+// pdfExportWriter lays the rows out as a table on landscape A4 pages, every column gets the same
+// width and a value too long for it is cut short.
+type pdfExportWriter struct {
+	pdf         *gofpdf.Fpdf
+	translate   func(string) string
+	columnWidth float64
+	w           io.Writer
+}
+
+const (
+	pdfExportMargin    = 10
+	pdfExportRowHeight = 6
+)
+
+func newPdfExportWriter(title string, header []string, w io.Writer) (writer *pdfExportWriter) {
+	pdf := gofpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(pdfExportMargin, pdfExportMargin, pdfExportMargin)
+	pdf.SetAutoPageBreak(true, pdfExportMargin)
+
+	pageWidth, _ := pdf.GetPageSize()
+
+	writer = &pdfExportWriter{
+		pdf:         pdf,
+		translate:   pdf.UnicodeTranslatorFromDescriptor(""),
+		columnWidth: (pageWidth - 2*pdfExportMargin) / float64(len(header)),
+		w:           w,
+	}
+
+	// Repeat the title and the header row on every page
+	pdf.SetHeaderFunc(func() {
+		pdf.SetFont("Helvetica", "B", 11)
+		pdf.CellFormat(0, 8, writer.translate(title), "", 1, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "B", 8)
+		pdf.SetFillColor(230, 230, 230)
+
+		for i := range header {
+			pdf.CellFormat(writer.columnWidth, pdfExportRowHeight, writer.fit(header[i]), "1", 0, "L", true, 0, "")
+		}
+
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 8)
+	})
+
+	pdf.AddPage()
+
+	return
+}
+
+func (e *pdfExportWriter) WriteRow(row []string) error {
+	for i := range row {
+		e.pdf.CellFormat(e.columnWidth, pdfExportRowHeight, e.fit(row[i]), "1", 0, "L", false, 0, "")
+	}
+
+	e.pdf.Ln(-1)
+
+	return e.pdf.Error()
+}
+
+func (e *pdfExportWriter) Close() error {
+	return e.pdf.Output(e.w)
+}
+
+// fit shortens a value until it fits its column.
+func (e *pdfExportWriter) fit(value string) string {
+	value = e.translate(value)
+
+	if e.pdf.GetStringWidth(value) <= e.columnWidth-2 {
+		return value
+	}
+
+	for len(value) > 0 && e.pdf.GetStringWidth(value+"...") > e.columnWidth-2 {
+		value = value[:len(value)-1]
+	}
+
+	return value + "..."
+}
@@ -0,0 +1,164 @@
+package utility
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"io"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"github.com/xuri/excelize/v2"
+)
+
+// ImportRow is one data row of an import file, keyed by the lower cased column header. Line is
+// the row number as shown in the file, the header being line 1.
+type ImportRow struct {
+	Line   int
+	Values map[string]string
+}
+
+// Get returns the trimmed value of a column, a missing column reads as empty.
+func (row ImportRow) Get(column string) string {
+	return strings.TrimSpace(row.Values[column])
+}
+
+// GetList splits a list column on constants.ImportListSep and drops the empty items.
+func (row ImportRow) GetList(column string) (list []string) {
+	for _, item := range strings.Split(row.Get(column), constants.ImportListSep) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return
+}
+
+// ReadImportFile reads a csv or xlsx file, told apart by its extension. Only the first sheet of
+// a workbook is read and blank rows are skipped.
+func ReadImportFile(fileName string, file io.Reader) (listRow []ImportRow, err error) {
+	var records [][]string
+
+	switch strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".") {
+	case constants.ImportFormatCSV:
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+
+		if records, err = reader.ReadAll(); err != nil {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid csv file: %s", err.Error())
+			return
+		}
+	case constants.ImportFormatXLSX:
+		workbook, errOpen := excelize.OpenReader(file)
+		if errOpen != nil {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid xlsx file: %s", errOpen.Error())
+			return
+		}
+
+		defer workbook.Close()
+
+		if records, err = workbook.GetRows(workbook.GetSheetName(0)); err != nil {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid xlsx file: %s", err.Error())
+			return
+		}
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: import file must be a csv or xlsx file")
+		return
+	}
+
+	if len(records) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: import file has no header row")
+		return
+	}
+
+	header := make([]string, len(records[0]))
+	for i := range records[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(records[0][i], "\ufeff")))
+	}
+
+	for i := 1; i < len(records); i++ {
+		row := ImportRow{
+			Line:   i + 1,
+			Values: make(map[string]string, len(header)),
+		}
+
+		blank := true
+
+		for j := range records[i] {
+			if j >= len(header) || header[j] == "" {
+				continue
+			}
+
+			row.Values[header[j]] = records[i][j]
+			blank = blank && strings.TrimSpace(records[i][j]) == ""
+		}
+
+		if blank {
+			continue
+		}
+
+		listRow = append(listRow, row)
+	}
+
+	if len(listRow) > constants.ImportMaxRows {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: import file has more than %d rows", constants.ImportMaxRows)
+		return
+	}
+
+	return
+}
+
+// ReadImportFormFile reads an uploaded import file with ReadImportFile.
+func ReadImportFormFile(fileHeader *multipart.FileHeader) (listRow []ImportRow, err error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: cannot open import file: %s", err.Error())
+		return
+	}
+
+	defer file.Close()
+
+	return ReadImportFile(fileHeader.Filename, file)
+}
+
+// ImportInSavepoint runs the import of one row inside a savepoint of tx. A row rejected with a
+// business error is rolled back on its own and returned as rowErr so the other rows can still
+// be committed, any other failure is returned as err and should abort the whole import.
+func ImportInSavepoint(ctx context.Context, tx *sql.Tx, importRow func() error) (rowErr error, err error) {
+	if _, err = tx.ExecContext(ctx, "SAVEPOINT import_row"); err != nil {
+		log.FromCtx(ctx).Error(err, "failed create savepoint")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if rowErr = importRow(); rowErr != nil {
+		if errors.Is(rowErr, httpservice.ErrUnknownSource) {
+			err, rowErr = rowErr, nil
+			return
+		}
+
+		if _, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_row"); err != nil {
+			log.FromCtx(ctx).Error(err, "failed rollback to savepoint")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		return
+	}
+
+	if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT import_row"); err != nil {
+		log.FromCtx(ctx).Error(err, "failed release savepoint")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,275 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/customer/service"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteCustomer(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewCustomerService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	customer := e.Group("/customer")
+	customer.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "customer ok")
+	})
+
+	customer.POST("", listCustomer(svc), mddw.ValidateToken)
+	customer.GET("/:guid", getCustomer(svc), mddw.ValidateToken)
+	customer.POST("/create", createCustomer(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	customer.PUT("/:guid", updateCustomer(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	customer.DELETE("/:guid", deleteCustomer(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	customer.GET("/reactive/:guid", reactiveCustomer(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+
+	customer.GET("/:guid/addresses", listCustomerAddress(svc), mddw.ValidateToken)
+	customer.POST("/:guid/addresses", createCustomerAddress(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	customer.PUT("/:guid/addresses/:address_guid", updateCustomerAddress(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	customer.DELETE("/:guid/addresses/:address_guid", deleteCustomerAddress(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	customer.GET("/:guid/addresses/reactive/:address_guid", reactiveCustomerAddress(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+}
+
+func createCustomer(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.RegisterCustomerPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.CreateCustomer(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadCustomer(data), nil)
+	}
+}
+
+func updateCustomer(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.UpdateCustomerPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.UpdateCustomer(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadCustomer(data), nil)
+	}
+}
+
+func deleteCustomer(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.DeleteCustomer(ctx.Request().Context(), guid, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func reactiveCustomer(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.ReactiveCustomer(ctx.Request().Context(), guid, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func listCustomer(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListCustomerPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListCustomer(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListCustomer(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getCustomer(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetCustomer(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadCustomer(data), nil)
+	}
+}
+
+func listCustomerAddress(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		listData, err := svc.ListCustomerAddress(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadListCustomerAddress(listData), nil)
+	}
+}
+
+func createCustomerAddress(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.CustomerAddressPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.CreateCustomerAddress(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadCustomerAddress(data), nil)
+	}
+}
+
+func updateCustomerAddress(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		addressGUID := ctx.Param("address_guid")
+		if guid == "" || addressGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.CustomerAddressPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.UpdateCustomerAddress(ctx.Request().Context(), request.ToEntityUpdate(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid, addressGUID))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadCustomerAddress(data), nil)
+	}
+}
+
+func deleteCustomerAddress(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		addressGUID := ctx.Param("address_guid")
+		if guid == "" || addressGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.DeleteCustomerAddress(ctx.Request().Context(), guid, addressGUID, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func reactiveCustomerAddress(svc *service.CustomerService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		addressGUID := ctx.Param("address_guid")
+		if guid == "" || addressGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.ReactiveCustomerAddress(ctx.Request().Context(), guid, addressGUID, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
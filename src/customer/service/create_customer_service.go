@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *CustomerService) CreateCustomer(ctx context.Context, request sqlc.InsertCustomerParams) (customer sqlc.GetCustomerRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if _, err = q.GetUserBackoffice(ctx, request.CreatedBy); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get user backoffice by guid")
+		err = errors.WithStack(httpservice.ErrUserNotFound)
+
+		return
+	}
+
+	// Customer code is unique, inactive customers keep theirs
+	_, err = q.GetCustomerGuidByCustomerCode(ctx, request.CustomerCode)
+	if err == nil {
+		err = errors.WithStack(httpservice.ErrDuplicateCustomerCode)
+		return
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		log.FromCtx(ctx).Error(err, "failed get customer by customer code")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.InsertCustomer(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	customer, err = q.GetCustomer(ctx, request.Guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
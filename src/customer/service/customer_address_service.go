@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// CreateCustomerAddress adds a shipping address. A customer has at most one default address,
+// so a new default replaces the old one and the first address of a customer is the default.
+func (s *CustomerService) CreateCustomerAddress(ctx context.Context, request sqlc.InsertCustomerAddressParams) (address sqlc.CustomerAddress, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if err = validateActiveCustomer(ctx, q, request.CustomerGuid); err != nil {
+		return
+	}
+
+	if !request.IsDefault {
+		_, err = q.GetDefaultCustomerAddress(ctx, request.CustomerGuid)
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.FromCtx(ctx).Error(err, "failed get default customer address")
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+
+			request.IsDefault = true
+		}
+	}
+
+	if request.IsDefault {
+		if err = clearDefaultCustomerAddress(ctx, q, request.CustomerGuid); err != nil {
+			return
+		}
+	}
+
+	address, err = q.InsertCustomerAddress(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert customer address")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *CustomerService) UpdateCustomerAddress(ctx context.Context, request sqlc.UpdateCustomerAddressParams) (address sqlc.CustomerAddress, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if request.IsDefault {
+		if err = clearDefaultCustomerAddress(ctx, q, request.CustomerGuid); err != nil {
+			return
+		}
+	}
+
+	address, err = q.UpdateCustomerAddress(ctx, request)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrCustomerAddressNotFound)
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed update customer address")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *CustomerService) DeleteCustomerAddress(ctx context.Context, customerGUID string, guid string, userData sqlc.GetUserBackofficeRow) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	if err = q.DeleteCustomerAddress(ctx, sqlc.DeleteCustomerAddressParams{
+		DeletedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid:         guid,
+		CustomerGuid: customerGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete customer address")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *CustomerService) ReactiveCustomerAddress(ctx context.Context, customerGUID string, guid string, userData sqlc.GetUserBackofficeRow) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	if err = q.ReactiveCustomerAddress(ctx, sqlc.ReactiveCustomerAddressParams{
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid:         guid,
+		CustomerGuid: customerGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed reactive customer address")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *CustomerService) ListCustomerAddress(ctx context.Context, customerGUID string) (listAddress []sqlc.CustomerAddress, err error) {
+	q := sqlc.New(s.mainDB)
+
+	listAddress, err = q.ListCustomerAddress(ctx, customerGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list customer address")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ResolveCustomerAddress validates the customer an outbound movement or order ships to. The
+// address must be an active address of that customer, and when none is given the default
+// address is used if the customer has one. An address without a customer is refused.
+func ResolveCustomerAddress(ctx context.Context, q *sqlc.Queries, customerGUID sql.NullString, addressGUID sql.NullString) (customer sqlc.GetCustomerRow, address sqlc.CustomerAddress, err error) {
+	if !customerGUID.Valid {
+		if addressGUID.Valid {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: customer address requires a customer")
+		}
+
+		return
+	}
+
+	customer, err = q.GetCustomer(ctx, customerGUID.String)
+	if err != nil || customer.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get customer", "customer_guid", customerGUID.String)
+		err = errors.WithStack(httpservice.ErrCustomerNotFound)
+
+		return
+	}
+
+	if !addressGUID.Valid {
+		address, err = q.GetDefaultCustomerAddress(ctx, customerGUID.String)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = nil
+				return
+			}
+
+			log.FromCtx(ctx).Error(err, "failed get default customer address")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		return
+	}
+
+	address, err = q.GetCustomerAddress(ctx, addressGUID.String)
+	if err != nil || address.DeletedAt.Valid || address.CustomerGuid != customerGUID.String {
+		log.FromCtx(ctx).Error(err, "failed get customer address", "customer_address_guid", addressGUID.String)
+		err = errors.WithStack(httpservice.ErrCustomerAddressNotFound)
+
+		return
+	}
+
+	return
+}
+
+func validateActiveCustomer(ctx context.Context, q *sqlc.Queries, customerGUID string) (err error) {
+	customer, err := q.GetCustomer(ctx, customerGUID)
+	if err != nil || customer.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get customer", "customer_guid", customerGUID)
+		err = errors.WithStack(httpservice.ErrCustomerNotFound)
+
+		return
+	}
+
+	return
+}
+
+func clearDefaultCustomerAddress(ctx context.Context, q *sqlc.Queries, customerGUID string) (err error) {
+	if err = q.ClearDefaultCustomerAddress(ctx, customerGUID); err != nil {
+		log.FromCtx(ctx).Error(err, "failed clear default customer address")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *CustomerService) DeleteCustomer(ctx context.Context, guid string, userData sqlc.GetUserBackofficeRow) (err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if err = q.DeleteCustomer(ctx, sqlc.DeleteCustomerParams{
+		DeletedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid: guid,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
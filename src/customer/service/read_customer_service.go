@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *CustomerService) ListCustomer(ctx context.Context, request sqlc.ListCustomerParams) (listCustomer []sqlc.ListCustomerRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountCustomer(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listCustomer, err = q.ListCustomer(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *CustomerService) GetCustomer(ctx context.Context, guid string) (customer sqlc.GetCustomerRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	customer, err = q.GetCustomer(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get customer")
+		err = errors.WithStack(httpservice.ErrCustomerNotFound)
+
+		return
+	}
+
+	return
+}
+
+func (s *CustomerService) getCountCustomer(ctx context.Context, q *sqlc.Queries, request sqlc.ListCustomerParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountCustomerParams{
+		SetName:         request.SetName,
+		Name:            request.Name,
+		SetCustomerCode: request.SetCustomerCode,
+		CustomerCode:    request.CustomerCode,
+		SetActive:       request.SetActive,
+		Active:          request.Active,
+	}
+
+	totalData, err = q.GetCountCustomer(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type CustomerService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewCustomerService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *CustomerService {
+	return &CustomerService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *CustomerService) UpdateCustomer(ctx context.Context, request sqlc.UpdateCustomerParams) (customer sqlc.GetCustomerRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if _, err = q.GetUserBackoffice(ctx, request.UpdatedBy.String); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get user backoffice data")
+		err = errors.WithStack(httpservice.ErrUserNotFound)
+
+		return
+	}
+
+	if _, err = q.UpdateCustomer(ctx, request); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrCustomerNotFound)
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed update customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	customer, err = q.GetCustomer(ctx, request.Guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get customer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
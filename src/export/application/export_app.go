@@ -0,0 +1,70 @@
+package application
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	"github.com/wit-id/blueprint-backend-go/src/export/service"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"net/http"
+)
+
+func AddRouteExport(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewExportService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	exportBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "export")
+	exportBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "export ok")
+	})
+	exportBO.Use(mddw.ValidateToken)
+	exportBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	exportBO.GET("/:guid", getExportFile(svc, cfg))
+	exportBO.GET("/:guid/download", downloadExportFile(svc))
+}
+
+func getExportFile(svc *service.ExportService, cfg config.KVStore) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.GetExportFile(ctx.Request().Context(), guid, userData.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadExportFile(data, cfg.GetString(constants.ConfigPrefixRoutesBackoffice)), nil)
+	}
+}
+
+func downloadExportFile(svc *service.ExportService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		exportFile, content, err := svc.DownloadExportFile(ctx.Request().Context(), guid, userData.Guid)
+		if err != nil {
+			return err
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", exportFile.FileName))
+
+		return ctx.Blob(http.StatusOK, utility.ExportContentType(exportFile.Format), content)
+	}
+}
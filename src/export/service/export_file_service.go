@@ -0,0 +1,181 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ExportRender writes every row of an export through the writer.
+type ExportRender func(ctx context.Context, writer utility.ExportWriter) error
+
+// ExportFileJobPayload is the payload of an export file job, Request holds the list params the
+// export was asked for.
+type ExportFileJobPayload struct {
+	ExportFileID string          `json:"export_file_id"`
+	ExportType   string          `json:"export_type"`
+	Request      json.RawMessage `json:"request"`
+}
+
+// Export renders an export of up to constants.ExportSyncMaxRows rows right away and returns its
+// content. A larger export is recorded as pending together with a job rendering it from
+// listRequest, it can be downloaded from the returned export file once completed.
+func (s *ExportService) Export(ctx context.Context, request sqlc.InsertExportFileParams, listRequest interface{}, header []string, render ExportRender) (content []byte, exportFile sqlc.GetExportFileRow, err error) {
+	if request.TotalRows <= constants.ExportSyncMaxRows {
+		content, err = renderExportFile(ctx, request.Format, request.FileName, header, render)
+		return
+	}
+
+	jobPayload, err := json.Marshal(listRequest)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed marshal export request")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if jobPayload, err = json.Marshal(ExportFileJobPayload{ExportFileID: request.Guid, ExportType: request.ExportType, Request: jobPayload}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed marshal export file job")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if _, err = q.InsertExportFile(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert export file")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = jobService.EnqueueJob(ctx, q, sqlc.InsertJobParams{
+		Guid:        utility.GenerateGoogleUUID(),
+		JobType:     constants.JobTypeExportFile,
+		Payload:     jobPayload,
+		MaxAttempts: constants.JobMaxAttempts,
+		RunAt:       time.Now().UTC(),
+		CreatedBy:   sql.NullString{String: request.CreatedBy, Valid: true},
+	}); err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	exportFile, err = s.GetExportFile(ctx, request.Guid, request.CreatedBy)
+
+	return
+}
+
+// RunExportFile renders a pending export and stores the file. A failed render is returned for the
+// job to be retried, the export is only marked failed once lastAttempt gives up on it.
+func (s *ExportService) RunExportFile(ctx context.Context, guid string, header []string, render ExportRender, lastAttempt bool) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	exportFile, err := q.GetExportFile(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrExportFileNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get export file")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	// Already rendered by an earlier attempt that failed to report back
+	if exportFile.Status != constants.ExportStatusPending {
+		return
+	}
+
+	content, err := renderExportFile(ctx, exportFile.Format, exportFile.FileName, header, render)
+	if err != nil {
+		if !lastAttempt {
+			return
+		}
+
+		if errFail := q.FailExportFile(ctx, sqlc.FailExportFileParams{
+			Status:       constants.ExportStatusFailed,
+			ErrorMessage: sql.NullString{String: err.Error(), Valid: true},
+			Guid:         exportFile.Guid,
+		}); errFail != nil {
+			log.FromCtx(ctx).Error(errFail, "failed update export file")
+		}
+
+		return
+	}
+
+	if err = q.CompleteExportFile(ctx, sqlc.CompleteExportFileParams{
+		Status:  constants.ExportStatusCompleted,
+		Content: content,
+		Guid:    exportFile.Guid,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed update export file")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func renderExportFile(ctx context.Context, format string, fileName string, header []string, render ExportRender) (content []byte, err error) {
+	var buf bytes.Buffer
+
+	writer, err := utility.NewExportWriter(format, strings.TrimSuffix(fileName, filepath.Ext(fileName)), header, &buf)
+	if err != nil {
+		return
+	}
+
+	if err = render(ctx, writer); err != nil {
+		return
+	}
+
+	if err = writer.Close(); err != nil {
+		log.FromCtx(ctx).Error(err, "failed render export file")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return buf.Bytes(), nil
+}
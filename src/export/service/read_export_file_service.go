@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetExportFile returns an export of the given user, exports of others read as not found.
+func (s *ExportService) GetExportFile(ctx context.Context, guid string, userGUID string) (exportFile sqlc.GetExportFileRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	exportFile, err = q.GetExportFile(ctx, guid)
+	if err != nil || exportFile.CreatedBy != userGUID {
+		log.FromCtx(ctx).Error(err, "failed get export file")
+		err = errors.WithStack(httpservice.ErrExportFileNotFound)
+
+		return
+	}
+
+	return
+}
+
+// DownloadExportFile returns the content of a completed export of the given user.
+func (s *ExportService) DownloadExportFile(ctx context.Context, guid string, userGUID string) (exportFile sqlc.GetExportFileRow, content []byte, err error) {
+	exportFile, err = s.GetExportFile(ctx, guid, userGUID)
+	if err != nil {
+		return
+	}
+
+	if exportFile.Status != constants.ExportStatusCompleted {
+		err = errors.Wrapf(httpservice.ErrExportFileNotReady, "export file is %s", exportFile.Status)
+		return
+	}
+
+	content, err = sqlc.New(s.mainDB).GetExportFileContent(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get export file content")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
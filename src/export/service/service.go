@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type ExportService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewExportService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *ExportService {
+	return &ExportService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/job/service"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteJob(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewJobService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	jobBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "job")
+	jobBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "job ok")
+	})
+	jobBO.Use(mddw.ValidateToken)
+	jobBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	jobBO.POST("", createJob(svc))
+	jobBO.POST("/list", listJob(svc))
+	jobBO.GET("/schedule", listJobSchedule(svc))
+	jobBO.GET("/:guid", getJob(svc))
+	jobBO.POST("/:guid/retry", retryJob(svc))
+	jobBO.POST("/:guid/cancel", cancelJob(svc))
+}
+
+func createJob(svc *service.JobService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.CreateJobPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.Enqueue(ctx.Request().Context(), request.ToEntity(userBackoffice.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadJob(data), nil)
+	}
+}
+
+func listJob(svc *service.JobService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListJobPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListJob(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListJob(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func listJobSchedule(svc *service.JobService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		listData, err := svc.ListJobSchedule(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadListJobSchedule(listData), nil)
+	}
+}
+
+func getJob(svc *service.JobService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetJob(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadJob(data), nil)
+	}
+}
+
+func retryJob(svc *service.JobService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.RetryJob(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadJob(data), nil)
+	}
+}
+
+func cancelJob(svc *service.JobService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.CancelJob(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadJob(data), nil)
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// Enqueue stores a job for the worker, it runs once run_at has passed.
+func (s *JobService) Enqueue(ctx context.Context, request sqlc.InsertJobParams) (job sqlc.Job, err error) {
+	return EnqueueJob(ctx, sqlc.New(s.mainDB), request)
+}
+
+// EnqueueJob stores a job through q, so a service can enqueue it in the transaction of the work
+// it follows up on. The job is only picked up once that transaction commits.
+func EnqueueJob(ctx context.Context, q *sqlc.Queries, request sqlc.InsertJobParams) (job sqlc.Job, err error) {
+	if len(request.Payload) == 0 {
+		request.Payload = []byte("{}")
+	}
+
+	job, err = q.InsertJob(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert job", "job_type", request.JobType)
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"time"
+)
+
+// SyncJobSchedule stores the schedules of the job.schedule config, a map of job type to a five
+// field cron expression evaluated in UTC, e.g. stock-snapshot: "10 0 * * *". Schedules of job
+// types without a handler or with an invalid expression are skipped, schedules removed from the
+// config are deactivated.
+func (s *JobService) SyncJobSchedule(ctx context.Context, handlers map[string]JobHandler) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	listJobType := make([]string, 0)
+
+	for jobType, value := range s.cfg.GetStringMap(constants.ConfigJobSchedule) {
+		cronExpression := fmt.Sprint(value)
+
+		if _, ok := handlers[jobType]; !ok {
+			log.FromCtx(ctx).Warn("skip schedule of unknown job type", "job_type", jobType)
+			continue
+		}
+
+		nextRunAt, errParse := nextJobRun(cronExpression, time.Now())
+		if errParse != nil {
+			log.FromCtx(ctx).Error(errParse, "skip invalid job schedule", "job_type", jobType, "cron_expression", cronExpression)
+			continue
+		}
+
+		if _, err = q.UpsertJobSchedule(ctx, sqlc.UpsertJobScheduleParams{
+			Guid:           utility.GenerateGoogleUUID(),
+			JobType:        jobType,
+			CronExpression: cronExpression,
+			NextRunAt:      nextRunAt,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed upsert job schedule", "job_type", jobType)
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		listJobType = append(listJobType, jobType)
+	}
+
+	if err = q.DeactivateJobScheduleNotIn(ctx, listJobType); err != nil {
+		log.FromCtx(ctx).Error(err, "failed deactivate job schedule")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// EnqueueDueJobSchedule enqueues a job for every schedule whose time has come and plans its next
+// run. Runs missed while no worker was up are enqueued once, not once per missed time.
+func (s *JobService) EnqueueDueJobSchedule(ctx context.Context) (err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	// Locks the due schedules, a second worker skips them instead of enqueueing them twice
+	listSchedule, err := q.ListDueJobSchedule(ctx)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list due job schedule")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listSchedule {
+		nextRunAt, errParse := nextJobRun(listSchedule[i].CronExpression, time.Now())
+		if errParse != nil {
+			log.FromCtx(ctx).Error(errParse, "failed parse job schedule", "job_type", listSchedule[i].JobType)
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if _, err = EnqueueJob(ctx, q, sqlc.InsertJobParams{
+			Guid:        utility.GenerateGoogleUUID(),
+			JobType:     listSchedule[i].JobType,
+			MaxAttempts: constants.JobMaxAttempts,
+			RunAt:       time.Now().UTC(),
+		}); err != nil {
+			return
+		}
+
+		if err = q.UpdateJobScheduleNextRun(ctx, sqlc.UpdateJobScheduleNextRunParams{
+			NextRunAt: nextRunAt,
+			Guid:      listSchedule[i].Guid,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed update job schedule")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ListJobSchedule returns every stored schedule, including the deactivated ones.
+func (s *JobService) ListJobSchedule(ctx context.Context) (listSchedule []sqlc.JobSchedule, err error) {
+	listSchedule, err = sqlc.New(s.mainDB).ListJobSchedule(ctx)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list job schedule")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// nextJobRun returns the first time after from matching a cron expression, in UTC.
+func nextJobRun(cronExpression string, from time.Time) (nextRunAt time.Time, err error) {
+	schedule, err := cron.ParseStandard(cronExpression)
+	if err != nil {
+		return
+	}
+
+	return schedule.Next(from.UTC()), nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *JobService) ListJob(ctx context.Context, request sqlc.ListJobParams) (listJob []sqlc.Job, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	totalData, err = q.GetCountJob(ctx, sqlc.GetCountJobParams{
+		SetStatus:  request.SetStatus,
+		Status:     request.Status,
+		SetJobType: request.SetJobType,
+		JobType:    request.JobType,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list job")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listJob, err = q.ListJob(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list job")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *JobService) GetJob(ctx context.Context, guid string) (job sqlc.Job, err error) {
+	job, err = sqlc.New(s.mainDB).GetJob(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrJobNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get job")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// RetryJob queues a dead or cancelled job again with a fresh set of attempts.
+func (s *JobService) RetryJob(ctx context.Context, guid string) (job sqlc.Job, err error) {
+	job, err = sqlc.New(s.mainDB).RetryDeadJob(ctx, guid)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(err, "failed retry job")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if job, err = s.GetJob(ctx, guid); err != nil {
+			return
+		}
+
+		err = errors.Wrapf(httpservice.ErrJobNotRetryable, "job is %s", job.Status)
+
+		return
+	}
+
+	return
+}
+
+// CancelJob drops a job that has not started yet. A running job cannot be stopped.
+func (s *JobService) CancelJob(ctx context.Context, guid string) (job sqlc.Job, err error) {
+	job, err = sqlc.New(s.mainDB).CancelJob(ctx, guid)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(err, "failed cancel job")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if job, err = s.GetJob(ctx, guid); err != nil {
+			return
+		}
+
+		err = errors.Wrapf(httpservice.ErrJobNotCancellable, "job is %s", job.Status)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// JobHandler runs one claimed job. A returned error schedules a retry with backoff until the job
+// runs out of attempts, it is then kept as dead until retried from the backoffice.
+type JobHandler func(ctx context.Context, job sqlc.Job) error
+
+var (
+	errNoJobHandler = errors.New("no handler for job type")
+	errJobPanic     = errors.New("recovered from job panic")
+)
+
+// RunJobWorker runs the due jobs with their handler until ctx is done. Every poll interval it
+// queues the due schedules, takes back the jobs of workers that stopped mid run and then works
+// through the queue. Workers may share the queue, a job is only ever claimed by one of them.
+func (s *JobService) RunJobWorker(ctx context.Context, handlers map[string]JobHandler) {
+	workerID := jobWorkerID()
+
+	pollInterval := s.cfg.GetDuration(constants.ConfigJobPollInterval)
+	if pollInterval <= 0 {
+		pollInterval = constants.JobPollInterval
+	}
+
+	// errors are already logged, the worker still runs the jobs enqueued by the api
+	_ = s.SyncJobSchedule(ctx, handlers)
+
+	log.FromCtx(ctx).Info("job worker started", "worker", workerID, "poll_interval", pollInterval.String())
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// errors are already logged, the next tick simply tries again
+		_ = s.RequeueStaleJob(ctx)
+		_ = s.EnqueueDueJobSchedule(ctx)
+
+		for ctx.Err() == nil {
+			ran, err := s.RunNextJob(ctx, workerID, handlers)
+			if err != nil || !ran {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunNextJob claims the oldest due job and runs it, ran is false when no job is due.
+func (s *JobService) RunNextJob(ctx context.Context, workerID string, handlers map[string]JobHandler) (ran bool, err error) {
+	q := sqlc.New(s.mainDB)
+
+	job, err := q.ClaimJob(ctx, sql.NullString{String: workerID, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed claim job")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	ran = true
+
+	handler, ok := handlers[job.JobType]
+	if !ok {
+		// Retrying cannot help until a worker knows the job type
+		job.Attempts = job.MaxAttempts
+		err = s.finishJob(ctx, q, job, errors.Wrapf(errNoJobHandler, "job_type=%s", job.JobType))
+
+		return
+	}
+
+	errRun := s.runJobWithHeartbeat(ctx, q, handler, job)
+
+	// Store the outcome even when the worker is stopping, else the job waits for the lock timeout
+	err = s.finishJob(context.Background(), q, job, errRun)
+
+	return
+}
+
+// RequeueStaleJob takes back the jobs left running by a worker that stopped before finishing
+// them, seen by their lock no longer being refreshed. A job that already used its last attempt
+// is marked dead instead.
+func (s *JobService) RequeueStaleJob(ctx context.Context) (err error) {
+	if err = sqlc.New(s.mainDB).RequeueStaleJob(ctx, sql.NullTime{Time: time.Now().UTC().Add(-s.jobLockTimeout()), Valid: true}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed requeue stale job")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// finishJob stores the outcome of a run. A failed job is retried after a backoff that doubles
+// with every attempt, or marked dead once it used its last attempt. The outcome is dropped when
+// the worker no longer holds the job lock, the job then belongs to whoever took it back.
+func (s *JobService) finishJob(ctx context.Context, q *sqlc.Queries, job sqlc.Job, errRun error) (err error) {
+	switch {
+	case errRun == nil:
+		_, err = q.CompleteJob(ctx, sqlc.CompleteJobParams{
+			Guid:     job.Guid,
+			LockedBy: job.LockedBy,
+		})
+	case job.Attempts >= job.MaxAttempts:
+		log.FromCtx(ctx).Error(errRun, "job is dead", "job_type", job.JobType, "job_guid", job.Guid, "attempts", job.Attempts)
+
+		_, err = q.DeadJob(ctx, sqlc.DeadJobParams{
+			LastError: sql.NullString{String: errRun.Error(), Valid: true},
+			Guid:      job.Guid,
+			LockedBy:  job.LockedBy,
+		})
+	default:
+		retryDelay := jobRetryDelay(job.Attempts)
+		log.FromCtx(ctx).Error(errRun, "job failed", "job_type", job.JobType, "job_guid", job.Guid, "attempts", job.Attempts, "retry_in", retryDelay.String())
+
+		_, err = q.RetryJob(ctx, sqlc.RetryJobParams{
+			RunAt:     time.Now().UTC().Add(retryDelay),
+			LastError: sql.NullString{String: errRun.Error(), Valid: true},
+			Guid:      job.Guid,
+			LockedBy:  job.LockedBy,
+		})
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		log.FromCtx(ctx).Warn("job lock lost before the run finished, outcome dropped", "job_type", job.JobType, "job_guid", job.Guid)
+		err = nil
+
+		return
+	}
+
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed update job", "job_guid", job.Guid)
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// runJobWithHeartbeat runs the handler while refreshing the job lock every third of the lock
+// timeout, so a long run is not taken back as stale. Losing the lock cancels the handler.
+func (s *JobService) runJobWithHeartbeat(ctx context.Context, q *sqlc.Queries, handler JobHandler, job sqlc.Job) (errRun error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(s.jobLockTimeout() / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			if _, err := q.HeartbeatJob(ctx, sqlc.HeartbeatJobParams{
+				Guid:     job.Guid,
+				LockedBy: job.LockedBy,
+			}); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					log.FromCtx(ctx).Warn("job lock lost, cancelling the run", "job_type", job.JobType, "job_guid", job.Guid)
+					cancel()
+
+					return
+				}
+
+				// A failed refresh is tried again on the next tick, well before the lock times out
+				log.FromCtx(ctx).Error(err, "failed refresh job lock", "job_guid", job.Guid)
+			}
+		}
+	}()
+
+	errRun = runJobHandler(runCtx, handler, job)
+
+	// Stop refreshing before the outcome is stored
+	close(done)
+	wg.Wait()
+
+	return
+}
+
+// jobLockTimeout is how long a running job may go without a heartbeat before it is taken back.
+func (s *JobService) jobLockTimeout() time.Duration {
+	lockTimeout := s.cfg.GetDuration(constants.ConfigJobLockTimeout)
+	if lockTimeout <= 0 {
+		lockTimeout = constants.JobLockTimeout
+	}
+
+	return lockTimeout
+}
+
+// runJobHandler turns a panic of the handler into a failed run.
+func runJobHandler(ctx context.Context, handler JobHandler, job sqlc.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrapf(errJobPanic, "caused_by %v", r)
+			log.FromCtx(ctx).Error(err, "found panic while running job", "job_guid", job.Guid, "panic_stack", debug.Stack())
+		}
+	}()
+
+	return handler(ctx, job)
+}
+
+// jobRetryDelay is the wait before the next attempt, attempts being the attempts made so far.
+func jobRetryDelay(attempts int64) (delay time.Duration) {
+	delay = constants.JobRetryBaseDelay
+
+	for i := int64(1); i < attempts && delay < constants.JobRetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > constants.JobRetryMaxDelay {
+		delay = constants.JobRetryMaxDelay
+	}
+
+	return
+}
+
+func jobWorkerID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/spf13/viper"
+
+	"github.com/wit-id/blueprint-backend-go/src/job/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+)
+
+func TestJobService_RunNextJob(t *testing.T) {
+	jobColumns := []string{"id", "guid", "job_type", "payload", "status", "attempts", "max_attempts", "run_at", "last_error",
+		"locked_at", "locked_by", "created_at", "created_by", "completed_at"}
+	now := time.Now()
+
+	handlers := map[string]service.JobHandler{
+		"succeed": func(ctx context.Context, job sqlc.Job) error {
+			return nil
+		},
+		"fail": func(ctx context.Context, job sqlc.Job) error {
+			return errors.New("failed")
+		},
+		"panic": func(ctx context.Context, job sqlc.Job) error {
+			panic("boom")
+		},
+	}
+
+	tests := []struct {
+		name       string
+		noJobDue   bool
+		jobType    string
+		attempts   int64
+		lockLost   bool
+		wantRan    bool
+		wantFinish string
+	}{
+		{
+			name:     "nothing to run",
+			noJobDue: true,
+		},
+		{
+			name:       "completes a successful job",
+			jobType:    "succeed",
+			attempts:   1,
+			wantRan:    true,
+			wantFinish: "CompleteJob",
+		},
+		{
+			name:       "retries a failed job with attempts left",
+			jobType:    "fail",
+			attempts:   2,
+			wantRan:    true,
+			wantFinish: "RetryJob",
+		},
+		{
+			name:       "retries a job that panicked",
+			jobType:    "panic",
+			attempts:   1,
+			wantRan:    true,
+			wantFinish: "RetryJob",
+		},
+		{
+			name:       "marks a job failing its last attempt dead",
+			jobType:    "fail",
+			attempts:   3,
+			wantRan:    true,
+			wantFinish: "DeadJob",
+		},
+		{
+			name:       "drops the outcome of a job whose lock was taken back",
+			jobType:    "succeed",
+			attempts:   1,
+			lockLost:   true,
+			wantRan:    true,
+			wantFinish: "CompleteJob",
+		},
+		{
+			name:       "marks a job without handler dead right away",
+			jobType:    "unknown",
+			attempts:   1,
+			wantRan:    true,
+			wantFinish: "DeadJob",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			claim := mock.ExpectQuery("-- name: ClaimJob")
+			if tt.noJobDue {
+				claim.WillReturnRows(sqlmock.NewRows(jobColumns))
+			} else {
+				claim.WillReturnRows(sqlmock.NewRows(jobColumns).
+					AddRow(1, "job-1", tt.jobType, []byte("{}"), "running", tt.attempts, 3, now, nil, now, "worker-1", now, nil, nil))
+
+				finished := sqlmock.NewRows([]string{"guid"})
+				if !tt.lockLost {
+					finished.AddRow("job-1")
+				}
+
+				mock.ExpectQuery("-- name: " + tt.wantFinish).WillReturnRows(finished)
+			}
+
+			s := service.NewJobService(db, viper.New())
+
+			gotRan, err := s.RunNextJob(ctx, "worker-1", handlers)
+			if err != nil {
+				t.Errorf("RunNextJob() error = %v", err)
+				return
+			}
+
+			if gotRan != tt.wantRan {
+				t.Errorf("RunNextJob() ran = %v, want %v", gotRan, tt.wantRan)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type JobService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewJobService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *JobService {
+	return &JobService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
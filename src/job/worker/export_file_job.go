@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	exportService "github.com/wit-id/blueprint-backend-go/src/export/service"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	productService "github.com/wit-id/blueprint-backend-go/src/product/product/service"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	userBackofficeService "github.com/wit-id/blueprint-backend-go/src/user_backoffice/service"
+	userHandheldService "github.com/wit-id/blueprint-backend-go/src/user_handheld/service"
+	warehouseService "github.com/wit-id/blueprint-backend-go/src/warehouse/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+var errUnknownExportType = errors.New("unknown export type")
+
+// exportFileJob renders an export too large to be answered right away. The list params stored
+// with the job are read back into the params of its export type, the export file is only marked
+// failed once the last attempt failed too.
+func exportFileJob(mainDB *sql.DB, cfg config.KVStore) jobService.JobHandler {
+	exportSvc := exportService.NewExportService(mainDB, cfg)
+	productSvc := productService.NewProductService(mainDB, cfg)
+	productHistorySvc := productHistoryService.NewProductHistoryService(mainDB, cfg)
+	userBackofficeSvc := userBackofficeService.NewUserBackofficeService(mainDB, cfg)
+	userHandheldSvc := userHandheldService.NewUserHandheldService(mainDB, cfg)
+	warehouseSvc := warehouseService.NewWarehouseService(mainDB, cfg)
+
+	return func(ctx context.Context, job sqlc.Job) (err error) {
+		var jobPayload exportService.ExportFileJobPayload
+		if err = json.Unmarshal(job.Payload, &jobPayload); err != nil {
+			return errors.Wrap(err, "invalid export file job payload")
+		}
+
+		var (
+			listRequest interface{}
+			header      []string
+			render      exportService.ExportRender
+		)
+
+		// render reads the params it closes over, they are filled in below before it runs
+		switch jobPayload.ExportType {
+		case constants.ExportTypeProduct:
+			var request sqlc.ListProductParams
+
+			listRequest, header = &request, payload.ExportHeaderProduct
+			render = func(renderCtx context.Context, writer utility.ExportWriter) error {
+				return productSvc.ExportProduct(renderCtx, request, func(listProduct []sqlc.ListProductRow, listCategory []sqlc.ListProductCategoryByProductsRow) error {
+					return utility.WriteExportRows(writer, payload.ToExportRowsProduct(listProduct, listCategory))
+				})
+			}
+		case constants.ExportTypeWarehouse:
+			var request sqlc.ListWarehouseParams
+
+			listRequest, header = &request, payload.ExportHeaderWarehouse
+			render = func(renderCtx context.Context, writer utility.ExportWriter) error {
+				return warehouseSvc.ExportWarehouse(renderCtx, request, func(listWarehouse []sqlc.ListWarehouseRow) error {
+					return utility.WriteExportRows(writer, payload.ToExportRowsWarehouse(listWarehouse))
+				})
+			}
+		case constants.ExportTypeUserBackoffice:
+			var request sqlc.ListUserBackofficeParams
+
+			listRequest, header = &request, payload.ExportHeaderUserBackoffice
+			render = func(renderCtx context.Context, writer utility.ExportWriter) error {
+				return userBackofficeSvc.ExportUserBackoffice(renderCtx, request, func(listUserBackoffice []sqlc.ListUserBackofficeRow) error {
+					return utility.WriteExportRows(writer, payload.ToExportRowsUserBackoffice(listUserBackoffice))
+				})
+			}
+		case constants.ExportTypeUserHandheld:
+			var request sqlc.ListUserHandheldParams
+
+			listRequest, header = &request, payload.ExportHeaderUserHandheld
+			render = func(renderCtx context.Context, writer utility.ExportWriter) error {
+				return userHandheldSvc.ExportUserHandheld(renderCtx, request, func(listUserHandheld []sqlc.UserHandheld) error {
+					return utility.WriteExportRows(writer, payload.ToExportRowsUserHandheld(listUserHandheld))
+				})
+			}
+		case constants.ExportTypeProductHistory:
+			var request sqlc.ListWithFilterProductHistoryParams
+
+			listRequest, header = &request, payload.ExportHeaderProductHistory
+			render = func(renderCtx context.Context, writer utility.ExportWriter) error {
+				return productHistorySvc.ExportProductHistory(renderCtx, request, func(listProductHistory []sqlc.ProductsHistory) error {
+					return utility.WriteExportRows(writer, payload.ToExportRowsProductHistory(listProductHistory))
+				})
+			}
+		default:
+			return errors.Wrapf(errUnknownExportType, "export_type=%s", jobPayload.ExportType)
+		}
+
+		if err = json.Unmarshal(jobPayload.Request, listRequest); err != nil {
+			return errors.Wrap(err, "invalid export request")
+		}
+
+		return exportSvc.RunExportFile(ctx, jobPayload.ExportFileID, header, render, job.Attempts >= job.MaxAttempts)
+	}
+}
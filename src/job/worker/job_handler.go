@@ -0,0 +1,20 @@
+package worker
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/fcm"
+)
+
+// NewJobHandler returns the handler of every job type, keyed by the job type. The job worker
+// runs queued jobs through it, cmd/job also runs a single job with it.
+func NewJobHandler(mainDB *sql.DB, cfg config.KVStore, sender fcm.Sender) map[string]jobService.JobHandler {
+	return map[string]jobService.JobHandler{
+		constants.JobTypeExportFile:             exportFileJob(mainDB, cfg),
+		constants.JobTypeReorderPoint:           reorderPointJob(mainDB, cfg, sender),
+		constants.JobTypeStockReservationExpiry: stockReservationExpiryJob(mainDB, cfg),
+		constants.JobTypeStockSnapshot:          stockSnapshotJob(mainDB, cfg),
+	}
+}
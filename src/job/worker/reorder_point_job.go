@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockAlertService "github.com/wit-id/blueprint-backend-go/src/stock_alert/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/fcm"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// reorderPointJob evaluates the reorder points and notifies the handhelds of the opened alerts.
+func reorderPointJob(mainDB *sql.DB, cfg config.KVStore, sender fcm.Sender) jobService.JobHandler {
+	svc := stockAlertService.NewStockAlertService(mainDB, cfg)
+
+	return func(ctx context.Context, job sqlc.Job) (err error) {
+		listAlert, err := svc.EvaluateReorderPoint(ctx, sender)
+		if err != nil {
+			return
+		}
+
+		log.FromCtx(ctx).Info("reorder point evaluated", "opened_alert", len(listAlert))
+
+		return
+	}
+}
@@ -0,0 +1,19 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockReservationService "github.com/wit-id/blueprint-backend-go/src/stock_reservation/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+// stockReservationExpiryJob expires timed out stock reservations.
+func stockReservationExpiryJob(mainDB *sql.DB, cfg config.KVStore) jobService.JobHandler {
+	svc := stockReservationService.NewStockReservationService(mainDB, cfg)
+
+	return func(ctx context.Context, job sqlc.Job) error {
+		return svc.ExpireStockReservation(ctx)
+	}
+}
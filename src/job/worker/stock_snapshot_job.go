@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	jobService "github.com/wit-id/blueprint-backend-go/src/job/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	warehouseService "github.com/wit-id/blueprint-backend-go/src/warehouse/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// stockSnapshotJob snapshots the stock of the days that ended since the last run. Schedule it
+// nightly, shortly after midnight UTC, to keep point in time stock queries fast.
+func stockSnapshotJob(mainDB *sql.DB, cfg config.KVStore) jobService.JobHandler {
+	svc := warehouseService.NewWarehouseService(mainDB, cfg)
+
+	return func(ctx context.Context, job sqlc.Job) (err error) {
+		listDate, err := svc.TakeStockSnapshot(ctx)
+		if err != nil {
+			return
+		}
+
+		log.FromCtx(ctx).Info("stock snapshot taken", "snapshot_days", len(listDate))
+
+		return
+	}
+}
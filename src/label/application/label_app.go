@@ -0,0 +1,134 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/label/service"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"net/http"
+)
+
+func AddRouteLabel(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewLabelService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	label := e.Group("/label")
+	label.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "label ok")
+	})
+
+	label.GET("/product/:guid", getProductLabel(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	label.GET("/bin/:guid", getBinLabel(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	label.GET("/warehouse/:guid", getWarehouseLabel(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	label.POST("/product/sheet", printProductLabelSheet(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+}
+
+func getProductLabel(svc *service.LabelService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ReadLabelPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, contentType, err := svc.GetProductLabel(ctx.Request().Context(), guid, request.Symbology, request.Format, request.Barcode)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+}
+
+func getBinLabel(svc *service.LabelService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ReadLabelPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, contentType, err := svc.GetBinLabel(ctx.Request().Context(), guid, request.Symbology, request.Format)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+}
+
+func getWarehouseLabel(svc *service.LabelService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ReadLabelPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, contentType, err := svc.GetWarehouseLabel(ctx.Request().Context(), guid, request.Symbology, request.Format)
+		if err != nil {
+			return err
+		}
+
+		return ctx.Blob(http.StatusOK, contentType, data)
+	}
+}
+
+func printProductLabelSheet(svc *service.LabelService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.PrintProductLabelSheetPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.GetProductLabelSheet(ctx.Request().Context(), request.ProductIDs, request.Symbology)
+		if err != nil {
+			return err
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="labels.pdf"`)
+
+		return ctx.Blob(http.StatusOK, "application/pdf", data)
+	}
+}
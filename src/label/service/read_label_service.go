@@ -0,0 +1,228 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// Label sheet layout in millimetres, 3 x 8 labels of 70 x 37 on A4
+const (
+	sheetColumns     = 3
+	sheetRows        = 8
+	sheetLabelWidth  = 70.0
+	sheetLabelHeight = 37.0
+	sheetPadding     = 3.0
+)
+
+func (s *LabelService) GetProductLabel(ctx context.Context, guid string, symbology string, format string, requestedBarcode string) (data []byte, contentType string, err error) {
+	q := sqlc.New(s.mainDB)
+
+	product, err := q.GetProduct(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	listBarcode, err := q.ListProductBarcodeByProduct(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product barcode")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	content, err := productLabelContent(product, listBarcode, symbology, requestedBarcode)
+	if err != nil {
+		return
+	}
+
+	return renderLabel(symbology, format, content)
+}
+
+func (s *LabelService) GetBinLabel(ctx context.Context, guid string, symbology string, format string) (data []byte, contentType string, err error) {
+	q := sqlc.New(s.mainDB)
+
+	bin, err := q.GetWarehouseLocation(ctx, guid)
+	if err != nil || bin.LocationType != constants.WarehouseLocationTypeBin {
+		log.FromCtx(ctx).Error(err, "failed get warehouse location")
+		err = errors.WithStack(httpservice.ErrWarehouseLocationNotFound)
+
+		return
+	}
+
+	if symbology == constants.ProductBarcodeTypeEAN13 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: bin labels support code128 and qr only")
+		return
+	}
+
+	return renderLabel(symbology, format, bin.Code)
+}
+
+func (s *LabelService) GetWarehouseLabel(ctx context.Context, guid string, symbology string, format string) (data []byte, contentType string, err error) {
+	q := sqlc.New(s.mainDB)
+
+	warehouse, err := q.GetWarehouse(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	if symbology == constants.ProductBarcodeTypeEAN13 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: warehouse labels support code128 and qr only")
+		return
+	}
+
+	return renderLabel(symbology, format, warehouse.WarehouseCode)
+}
+
+// GetProductLabelSheet lays out one label per product on A4 pages, in the order given.
+func (s *LabelService) GetProductLabelSheet(ctx context.Context, listProductGUID []string, symbology string) (data []byte, err error) {
+	q := sqlc.New(s.mainDB)
+
+	listBarcode, err := q.ListProductBarcodeByProducts(ctx, listProductGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product barcode")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	productBarcode := make(map[string][]sqlc.ProductBarcode)
+	for i := range listBarcode {
+		productBarcode[listBarcode[i].ProductGuid] = append(productBarcode[listBarcode[i].ProductGuid], listBarcode[i])
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetFont("Helvetica", "", 8)
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	marginX := (pageWidth - sheetColumns*sheetLabelWidth) / 2
+	marginY := (pageHeight - sheetRows*sheetLabelHeight) / 2
+
+	for i := range listProductGUID {
+		product, errProduct := q.GetProduct(ctx, listProductGUID[i])
+		if errProduct != nil {
+			log.FromCtx(ctx).Error(errProduct, "failed get product")
+			err = errors.Wrapf(httpservice.ErrProductNotFound, "product %s not found", listProductGUID[i])
+
+			return
+		}
+
+		content, errContent := productLabelContent(product, productBarcode[product.Guid], symbology, "")
+		if errContent != nil {
+			err = errors.Wrapf(errContent, "product %s", product.Name.String)
+			return
+		}
+
+		code, errCode := encodeBarcode(symbology, content)
+		if errCode != nil {
+			err = errCode
+			return
+		}
+
+		image, errImage := renderPNG(code)
+		if errImage != nil {
+			err = errImage
+			return
+		}
+
+		slot := i % (sheetColumns * sheetRows)
+		if slot == 0 {
+			pdf.AddPage()
+		}
+
+		x := marginX + float64(slot%sheetColumns)*sheetLabelWidth + sheetPadding
+		y := marginY + float64(slot/sheetColumns)*sheetLabelHeight + sheetPadding
+		innerWidth := sheetLabelWidth - 2*sheetPadding
+
+		pdf.SetXY(x, y)
+		pdf.CellFormat(innerWidth, 4, translate(product.Name.String), "", 0, "C", false, 0, "")
+
+		imageName := fmt.Sprintf("label-%d", i)
+		imageOptions := gofpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(imageName, imageOptions, bytes.NewReader(image))
+
+		// Keep the aspect ratio of the rendered barcode inside the space left under the name
+		imageWidth, imageHeight := innerWidth, sheetLabelHeight-2*sheetPadding-9
+		if !isLinear(code) {
+			imageWidth = imageHeight
+		}
+
+		pdf.ImageOptions(imageName, x+(innerWidth-imageWidth)/2, y+4.5, imageWidth, imageHeight, false, imageOptions, 0, "")
+
+		pdf.SetXY(x, y+sheetLabelHeight-2*sheetPadding-4)
+		pdf.CellFormat(innerWidth, 4, translate(content), "", 0, "C", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err = pdf.Output(&buf); err != nil {
+		log.FromCtx(ctx).Error(err, "failed render label sheet")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return buf.Bytes(), nil
+}
+
+// productLabelContent picks what goes into a product label. EAN-13 needs a GTIN barcode of the
+// product, a UPC is widened to 13 digits. Code 128 and QR use the SKU, falling back to the
+// first barcode. A requested barcode must belong to the product.
+func productLabelContent(product sqlc.GetProductRow, listBarcode []sqlc.ProductBarcode, symbology string, requestedBarcode string) (content string, err error) {
+	if requestedBarcode != "" {
+		if requestedBarcode == product.Sku.String {
+			return requestedBarcode, nil
+		}
+
+		for i := range listBarcode {
+			if listBarcode[i].Barcode == requestedBarcode {
+				return requestedBarcode, nil
+			}
+		}
+
+		err = errors.Wrapf(httpservice.ErrProductBarcodeNotFound, "barcode %s does not belong to the product", requestedBarcode)
+
+		return
+	}
+
+	if symbology == constants.ProductBarcodeTypeEAN13 {
+		for i := range listBarcode {
+			switch listBarcode[i].BarcodeType {
+			case constants.ProductBarcodeTypeEAN13:
+				return listBarcode[i].Barcode, nil
+			case constants.ProductBarcodeTypeUPC:
+				return "0" + listBarcode[i].Barcode, nil
+			}
+		}
+
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product has no EAN-13 or UPC barcode")
+
+		return
+	}
+
+	if product.Sku.Valid && product.Sku.String != "" {
+		return product.Sku.String, nil
+	}
+
+	if len(listBarcode) > 0 {
+		return listBarcode[0].Barcode, nil
+	}
+
+	err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product has no sku or barcode to print")
+
+	return
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/qr"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"image/png"
+)
+
+const (
+	linearModuleWidth = 2
+	linearHeight      = 80
+	linearQuietZone   = 10 // modules
+	qrModuleSize      = 8
+	qrQuietZone       = 4 // modules
+	svgTextHeight     = 20
+)
+
+// encodeBarcode turns the content into an unscaled barcode of the given symbology.
+func encodeBarcode(symbology string, content string) (code barcode.Barcode, err error) {
+	switch symbology {
+	case constants.ProductBarcodeTypeCode128:
+		code, err = code128.Encode(content)
+	case constants.ProductBarcodeTypeEAN13:
+		code, err = ean.Encode(content)
+	case constants.ProductBarcodeTypeQR:
+		code, err = qr.Encode(content, qr.M, qr.Auto)
+	default:
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: unsupported symbology %q", symbology)
+		return
+	}
+
+	if err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s cannot be encoded as %s: %s", content, symbology, err.Error())
+		return
+	}
+
+	return
+}
+
+// renderLabel encodes the content and writes it as a png or svg image.
+func renderLabel(symbology string, format string, content string) (data []byte, contentType string, err error) {
+	code, err := encodeBarcode(symbology, content)
+	if err != nil {
+		return
+	}
+
+	switch format {
+	case constants.LabelFormatSVG:
+		return renderSVG(code, content), "image/svg+xml", nil
+	default:
+		data, err = renderPNG(code)
+		return data, "image/png", err
+	}
+}
+
+func renderPNG(code barcode.Barcode) (data []byte, err error) {
+	width, height := scaledSize(code)
+
+	scaled, err := barcode.Scale(code, width, height)
+	if err != nil {
+		err = errors.Wrap(httpservice.ErrUnknownSource, err.Error())
+		return
+	}
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, scaled); err != nil {
+		err = errors.Wrap(httpservice.ErrUnknownSource, err.Error())
+		return
+	}
+
+	return buf.Bytes(), nil
+}
+
+// renderSVG draws every run of dark modules as one rect, with the content printed below
+// linear barcodes.
+func renderSVG(code barcode.Barcode, content string) (data []byte) {
+	bounds := code.Bounds()
+	linear := isLinear(code)
+	width, height := scaledSize(code)
+
+	module, quiet := qrModuleSize, qrQuietZone
+	if linear {
+		module, quiet = linearModuleWidth, linearQuietZone
+	}
+
+	totalHeight := height
+	if linear {
+		totalHeight += svgTextHeight
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, totalHeight, width, totalHeight)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#ffffff"/>`, width, totalHeight)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; {
+			if !isDark(code, x, y) {
+				x++
+				continue
+			}
+
+			start := x
+			for x < bounds.Max.X && isDark(code, x, y) {
+				x++
+			}
+
+			rectY, rectHeight := (y-bounds.Min.Y+quiet)*module, module
+			if linear {
+				rectY, rectHeight = 0, height
+			}
+
+			fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" fill="#000000"/>`, (start-bounds.Min.X+quiet)*module, rectY, (x-start)*module, rectHeight)
+		}
+	}
+
+	if linear {
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-family="monospace" font-size="14" text-anchor="middle">`, width/2, height+svgTextHeight-5)
+		_ = xml.EscapeText(&buf, []byte(content))
+		buf.WriteString(`</text>`)
+	}
+
+	buf.WriteString(`</svg>`)
+
+	return buf.Bytes()
+}
+
+// scaledSize is the pixel size of the barcode including its quiet zone.
+func scaledSize(code barcode.Barcode) (width int, height int) {
+	bounds := code.Bounds()
+
+	if isLinear(code) {
+		return (bounds.Dx() + 2*linearQuietZone) * linearModuleWidth, linearHeight
+	}
+
+	size := (bounds.Dx() + 2*qrQuietZone) * qrModuleSize
+
+	return size, size
+}
+
+// isLinear tells 1D barcodes, which are one pixel high before scaling, apart from QR codes.
+func isLinear(code barcode.Barcode) bool {
+	return code.Bounds().Dy() == 1
+}
+
+func isDark(code barcode.Barcode, x int, y int) bool {
+	r, g, b, _ := code.At(x, y).RGBA()
+
+	return r+g+b < 3*0x8000
+}
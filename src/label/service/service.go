@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type LabelService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewLabelService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *LabelService {
+	return &LabelService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -110,6 +110,7 @@ func (v *EnsureToken) ValidateUserBackofficeLogin(next echo.HandlerFunc) echo.Ha
 
 		// Set data user response to ...
 		ctx.Set(constants.MddwUserBackoffice, userBackofficeData)
+		ctx.Set(constants.MddwKeyRole, userBackofficeData)
 
 		return next(ctx)
 	}
@@ -1,10 +1,14 @@
 package application
 
 import (
+	"context"
+	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"github.com/wit-id/blueprint-backend-go/common/constants"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	exportService "github.com/wit-id/blueprint-backend-go/src/export/service"
 	"github.com/wit-id/blueprint-backend-go/src/middleware"
 	"github.com/wit-id/blueprint-backend-go/src/product/product/service"
 	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
@@ -17,6 +21,7 @@ import (
 
 func AddRouteProduct(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
 	svc := service.NewProductService(s.GetDB(), cfg)
+	exportSvc := exportService.NewExportService(s.GetDB(), cfg)
 
 	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
 
@@ -26,11 +31,24 @@ func AddRouteProduct(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
 	})
 
 	product.POST("", listProduct(svc))
+	product.GET("/barcode/:code", getProductBarcode(svc))
 	product.GET("/:guid", getProduct(svc))
+	product.GET("/:guid/stock", listProductStock(svc), mddw.ValidateToken)
+	product.GET("/:guid/stock/locations", listProductStockLocation(svc), mddw.ValidateToken)
 	product.POST("/create", createProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.POST("/import", importProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.POST("/export", exportProduct(svc, exportSvc, cfg), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	product.PUT("/:guid", updateProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	product.DELETE("/:guid", deleteProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	product.GET("/reactive/:guid", reactiveProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+
+	// Kitting (bill of materials, assembly and disassembly)
+	product.GET("/:guid/components", listProductComponent(svc), mddw.ValidateToken)
+	product.PUT("/:guid/components", updateProductComponent(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.POST("/:guid/assemble", assembleProduct(svc, constants.ProductAssemblyOperationAssemble), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.POST("/:guid/disassemble", assembleProduct(svc, constants.ProductAssemblyOperationDisassemble), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.POST("/:guid/assemblies", listProductAssembly(svc), mddw.ValidateToken)
+	product.GET("/assembly/:guid", getProductAssembly(svc), mddw.ValidateToken)
 }
 
 func createProduct(svc *service.ProductService) echo.HandlerFunc {
@@ -46,12 +64,118 @@ func createProduct(svc *service.ProductService) echo.HandlerFunc {
 			return err
 		}
 
-		data, userBackoffice, err := svc.CreateProduct(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)))
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, userBackoffice, listCategory, listBarcode, listUnit, err := svc.CreateProduct(ctx.Request().Context(), request.ToEntity(userData), request.CategoryIDs, request.ToEntityBarcode(userData), request.ToEntityUnit(userData))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadRegisterProduct(data, userBackoffice, listCategory, listBarcode, listUnit), nil)
+	}
+}
+
+func importProduct(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ImportPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		fileHeader, err := ctx.FormFile("file")
+		if err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to get import file")
+			return errors.Wrap(httpservice.ErrBadRequest, "bad request: file is required")
+		}
+
+		listRow, err := utility.ReadImportFormFile(fileHeader)
+		if err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		// Validate every row, only the valid ones reach the service
+		rowErrors := make(map[int]error)
+		listLine := make([]int, 0, len(listRow))
+		listProduct := make([]service.ProductImport, 0, len(listRow))
+
+		for i := range listRow {
+			var product payload.RegisterProductPayload
+			if err = product.FromImportRow(listRow[i]); err == nil {
+				err = product.Validate()
+			}
+
+			if err != nil {
+				rowErrors[listRow[i].Line] = err
+				continue
+			}
+
+			listLine = append(listLine, listRow[i].Line)
+			listProduct = append(listProduct, service.ProductImport{
+				Product:     product.ToEntity(userData),
+				CategoryIDs: product.CategoryIDs,
+				Barcodes:    product.ToEntityBarcode(userData),
+				Units:       product.ToEntityUnit(userData),
+			})
+		}
+
+		listError, err := svc.ImportProduct(ctx.Request().Context(), listProduct, request.DryRun)
 		if err != nil {
 			return err
 		}
 
-		return httpservice.ResponseData(ctx, payload.ToPayloadRegisterProduct(data, userBackoffice), nil)
+		for i := range listError {
+			if listError[i] != nil {
+				rowErrors[listLine[i]] = listError[i]
+			}
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadImport(request.DryRun, len(listRow), rowErrors), nil)
+	}
+}
+
+func exportProduct(svc *service.ProductService, exportSvc *exportService.ExportService, cfg config.KVStore) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ExportProductPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+		listRequest := request.ToEntity()
+
+		totalData, err := svc.GetCountProduct(ctx.Request().Context(), listRequest)
+		if err != nil {
+			return err
+		}
+
+		exportRequest := payload.ToEntityExportFile(constants.ExportTypeProduct, request.Format, totalData, userData)
+
+		content, exportFile, err := exportSvc.Export(ctx.Request().Context(), exportRequest, listRequest, payload.ExportHeaderProduct, func(renderCtx context.Context, writer utility.ExportWriter) error {
+			return svc.ExportProduct(renderCtx, listRequest, func(listProduct []sqlc.ListProductRow, listCategory []sqlc.ListProductCategoryByProductsRow) error {
+				return utility.WriteExportRows(writer, payload.ToExportRowsProduct(listProduct, listCategory))
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		// A large export is rendered in the background, answer with where to pick it up
+		if exportFile.Guid != "" {
+			return httpservice.ResponseData(ctx, payload.ToPayloadExportFile(exportFile, cfg.GetString(constants.ConfigPrefixRoutesBackoffice)), nil)
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", exportRequest.FileName))
+
+		return ctx.Blob(http.StatusOK, utility.ExportContentType(request.Format), content)
 	}
 }
 
@@ -73,12 +197,14 @@ func updateProduct(svc *service.ProductService) echo.HandlerFunc {
 			return err
 		}
 
-		data, userBackoffice, err := svc.UpdateProduct(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid))
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, userBackoffice, listCategory, listBarcode, listUnit, err := svc.UpdateProduct(ctx.Request().Context(), request.ToEntity(userData, guid), request.CategoryIDs, request.ToEntityBarcode(userData), request.ToEntityUnit(userData))
 		if err != nil {
 			return err
 		}
 
-		return httpservice.ResponseData(ctx, payload.ToPayloadUpdateProduct(data, userBackoffice), nil)
+		return httpservice.ResponseData(ctx, payload.ToPayloadUpdateProduct(data, userBackoffice, listCategory, listBarcode, listUnit), nil)
 	}
 }
 
@@ -129,7 +255,7 @@ func listProduct(svc *service.ProductService) echo.HandlerFunc {
 			return err
 		}
 
-		listData, totalData, err := svc.ListProduct(ctx.Request().Context(), request.ToEntity())
+		listData, listCategory, listBarcode, listUnit, totalData, err := svc.ListProduct(ctx.Request().Context(), request.ToEntity())
 		if err != nil {
 			return err
 		}
@@ -137,7 +263,7 @@ func listProduct(svc *service.ProductService) echo.HandlerFunc {
 		// TOTAL PAGE
 		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
 
-		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProduct(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProduct(listData, listCategory, listBarcode, listUnit), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
 	}
 }
 
@@ -148,11 +274,212 @@ func getProduct(svc *service.ProductService) echo.HandlerFunc {
 			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
 		}
 
-		data, err := svc.GetProduct(ctx.Request().Context(), guid)
+		data, listCategory, listBarcode, listUnit, err := svc.GetProduct(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProduct(data, listCategory, listBarcode, listUnit), nil)
+	}
+}
+
+func getProductBarcode(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		code := ctx.Param("code")
+		if code == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, "bad request: barcode is required")
+		}
+
+		productBarcode, conversionFactor, err := svc.GetProductBarcode(ctx.Request().Context(), code)
+		if err != nil {
+			return err
+		}
+
+		data, listCategory, listBarcode, listUnit, err := svc.GetProduct(ctx.Request().Context(), productBarcode.ProductGuid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductBarcodeLookup(productBarcode, conversionFactor, payload.ToPayloadProduct(data, listCategory, listBarcode, listUnit)), nil)
+	}
+}
+
+func listProductStock(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListStockBalancePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListProductStock(ctx.Request().Context(), request.ToEntityProduct(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProductStockBalance(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func listProductStockLocation(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListStockBalancePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListProductStockLocation(ctx.Request().Context(), request.ToEntityProductLocation(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProductStockLocationBalance(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func listProductComponent(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		listData, err := svc.ListProductComponent(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadListProductComponent(listData), nil)
+	}
+}
+
+func updateProductComponent(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.UpdateProductComponentPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		listData, err := svc.ReplaceProductComponent(ctx.Request().Context(), guid, request.ToEntity(guid, userData.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadListProductComponent(listData), nil)
+	}
+}
+
+func assembleProduct(svc *service.ProductService, operation string) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.InsertProductAssemblyPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, listMovement, err := svc.AssembleProduct(ctx.Request().Context(), request.ToEntity(guid, operation, userData.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductAssembly(data, listMovement), nil)
+	}
+}
+
+func listProductAssembly(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListProductAssemblyPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListProductAssembly(ctx.Request().Context(), request.ToEntity(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProductAssembly(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getProductAssembly(svc *service.ProductService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listMovement, err := svc.GetProductAssembly(ctx.Request().Context(), guid)
 		if err != nil {
 			return err
 		}
 
-		return httpservice.ResponseData(ctx, payload.ToPayloadProduct(data), nil)
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductAssembly(data, listMovement), nil)
 	}
 }
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// AssembleProduct builds or takes apart kits in one warehouse following the bill of materials,
+// all movements are posted in one transaction under the assembly document. Assembling consumes
+// the available component stock and the kits come in at the cost of the components. Taking
+// kits apart does the reverse, the cost of the kits is spread over the components by their
+// current average cost.
+func (s *ProductService) AssembleProduct(ctx context.Context, request sqlc.InsertProductAssemblyParams) (assembly sqlc.GetProductAssemblyRow, listMovement []sqlc.ProductsHistory, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	listComponent, err := listProductComponent(ctx, q, request.ProductGuid)
+	if err != nil {
+		return
+	}
+
+	if len(listComponent) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product has no bill of materials")
+		return
+	}
+
+	if _, err = q.InsertProductAssembly(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert product assembly")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if request.Operation == constants.ProductAssemblyOperationAssemble {
+		err = assembleKit(ctx, q, request, listComponent)
+	} else {
+		err = disassembleKit(ctx, q, request, listComponent)
+	}
+
+	if err != nil {
+		return
+	}
+
+	assembly, listMovement, err = getProductAssemblyWithMovements(ctx, q, request.Guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func assembleKit(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductAssemblyParams, listComponent []sqlc.ListProductComponentByProductRow) (err error) {
+	var totalCost int64
+
+	for i := range listComponent {
+		movement, errMovement := productHistoryService.RecordProductHistoryKeluar(ctx, q, sqlc.InsertKeluarProductsHistoryParams{
+			Guid:          utility.GenerateGoogleUUID(),
+			ProductGuid:   listComponent[i].ComponentProductGuid,
+			Quantity:      listComponent[i].Quantity * request.Quantity,
+			WarehouseGuid: request.WarehouseGuid,
+			PegawaiKeluar: request.CreatedBy,
+			CreatedBy:     request.CreatedBy,
+			ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceAssembly, Valid: true},
+			ReferenceGuid: sql.NullString{String: request.Guid, Valid: true},
+		}, nil)
+		if errMovement != nil {
+			err = errMovement
+			return
+		}
+
+		totalCost += movement.TotalCost.Int64
+	}
+
+	_, err = productHistoryService.RecordProductHistoryMasuk(ctx, q, sqlc.InsertProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   request.ProductGuid,
+		Quantity:      request.Quantity,
+		WarehouseGuid: request.WarehouseGuid,
+		PegawaiMasuk:  request.CreatedBy,
+		CreatedBy:     request.CreatedBy,
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceAssembly, Valid: true},
+		ReferenceGuid: sql.NullString{String: request.Guid, Valid: true},
+		TotalCost:     sql.NullInt64{Int64: totalCost, Valid: true},
+	}, nil)
+
+	return
+}
+
+func disassembleKit(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductAssemblyParams, listComponent []sqlc.ListProductComponentByProductRow) (err error) {
+	kit, err := productHistoryService.RecordProductHistoryKeluar(ctx, q, sqlc.InsertKeluarProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   request.ProductGuid,
+		Quantity:      request.Quantity,
+		WarehouseGuid: request.WarehouseGuid,
+		PegawaiKeluar: request.CreatedBy,
+		CreatedBy:     request.CreatedBy,
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceAssembly, Valid: true},
+		ReferenceGuid: sql.NullString{String: request.Guid, Valid: true},
+	}, nil)
+	if err != nil {
+		return
+	}
+
+	listCost, err := allocateComponentCost(ctx, q, request, listComponent, kit.TotalCost.Int64)
+	if err != nil {
+		return
+	}
+
+	for i := range listComponent {
+		if _, err = productHistoryService.RecordProductHistoryMasuk(ctx, q, sqlc.InsertProductsHistoryParams{
+			Guid:          utility.GenerateGoogleUUID(),
+			ProductGuid:   listComponent[i].ComponentProductGuid,
+			Quantity:      listComponent[i].Quantity * request.Quantity,
+			WarehouseGuid: request.WarehouseGuid,
+			PegawaiMasuk:  request.CreatedBy,
+			CreatedBy:     request.CreatedBy,
+			ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceAssembly, Valid: true},
+			ReferenceGuid: sql.NullString{String: request.Guid, Valid: true},
+			TotalCost:     sql.NullInt64{Int64: listCost[i], Valid: true},
+		}, nil); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// allocateComponentCost splits the cost of the kits taken apart over the components, weighted
+// by what each component line is worth at its current average cost in the warehouse. When no
+// component has a cost yet the split follows the quantities. The last line carries any
+// rounding left over so the value of the kits is kept whole.
+func allocateComponentCost(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductAssemblyParams, listComponent []sqlc.ListProductComponentByProductRow, totalCost int64) (listCost []int64, err error) {
+	weights := make([]int64, len(listComponent))
+
+	var totalWeight, totalQuantity int64
+
+	for i := range listComponent {
+		quantity := listComponent[i].Quantity * request.Quantity
+		totalQuantity += quantity
+
+		valuation, errValuation := q.GetStockValuationForUpdate(ctx, sqlc.GetStockValuationForUpdateParams{
+			ProductGuid:   listComponent[i].ComponentProductGuid,
+			WarehouseGuid: request.WarehouseGuid,
+		})
+		if errValuation != nil && !errors.Is(errValuation, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(errValuation, "failed get stock valuation")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if errValuation == nil && valuation.Quantity > 0 {
+			weights[i] = valuation.TotalValue * quantity / valuation.Quantity
+			totalWeight += weights[i]
+		}
+	}
+
+	if totalWeight == 0 {
+		for i := range listComponent {
+			weights[i] = listComponent[i].Quantity * request.Quantity
+		}
+
+		totalWeight = totalQuantity
+	}
+
+	listCost = make([]int64, len(listComponent))
+	remaining := totalCost
+
+	for i := range listComponent {
+		if i == len(listComponent)-1 {
+			listCost[i] = remaining
+			break
+		}
+
+		listCost[i] = totalCost * weights[i] / totalWeight
+		remaining -= listCost[i]
+	}
+
+	return
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/wit-id/blueprint-backend-go/toolkit/log"
 )
 
-func (s *ProductService) CreateProduct(ctx context.Context, request sqlc.InsertProductParams) (product sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow, err error) {
+func (s *ProductService) CreateProduct(ctx context.Context, request sqlc.InsertProductParams, listCategoryGUID []string, listBarcodeRequest []sqlc.InsertProductBarcodeParams, listUnitRequest []sqlc.InsertProductUnitParams) (product sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit, err error) {
 	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		log.FromCtx(ctx).Error(err, "failed begin tx")
@@ -39,6 +39,26 @@ func (s *ProductService) CreateProduct(ctx context.Context, request sqlc.InsertP
 		return
 	}
 
+	product, listCategory, listBarcode, listUnit, err = createProduct(ctx, q, request, listCategoryGUID, listBarcodeRequest, listUnitRequest)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func createProduct(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductParams, listCategoryGUID []string, listBarcodeRequest []sqlc.InsertProductBarcodeParams, listUnitRequest []sqlc.InsertProductUnitParams) (product sqlc.Product, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit, err error) {
+	if err = validateProductSku(ctx, q, request.Sku, request.Guid); err != nil {
+		return
+	}
+
 	product, err = q.InsertProduct(ctx, request)
 	if err != nil {
 		log.FromCtx(ctx).Error(err, "failed insert product")
@@ -47,10 +67,21 @@ func (s *ProductService) CreateProduct(ctx context.Context, request sqlc.InsertP
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.FromCtx(ctx).Error(err, "error commit")
-		err = errors.WithStack(httpservice.ErrUnknownSource)
+	listCategory, err = replaceProductCategory(ctx, q, product.Guid, listCategoryGUID, request.CreatedBy)
+	if err != nil {
+		return
+	}
 
+	if err = replaceProductUnit(ctx, q, product.Guid, listUnitRequest); err != nil {
+		return
+	}
+
+	if err = replaceProductBarcode(ctx, q, product.Guid, listBarcodeRequest); err != nil {
+		return
+	}
+
+	listBarcode, listUnit, err = listProductBarcodeUnit(ctx, q, product.Guid, product.BaseUnit)
+	if err != nil {
 		return
 	}
 
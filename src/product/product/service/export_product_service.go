@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetCountProduct counts the rows an export of the list filter holds.
+func (s *ProductService) GetCountProduct(ctx context.Context, request sqlc.ListProductParams) (totalData int64, err error) {
+	return s.getCountProduct(ctx, sqlc.New(s.mainDB), request)
+}
+
+// ExportProduct reads every product matching the list filter with its categories, a page of
+// request.LimitData rows at a time, and hands each page to writePage.
+func (s *ProductService) ExportProduct(ctx context.Context, request sqlc.ListProductParams, writePage func(listProduct []sqlc.ListProductRow, listCategory []sqlc.ListProductCategoryByProductsRow) error) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	for request.OffsetPage = 0; ; request.OffsetPage += request.LimitData {
+		listProduct, errList := q.ListProduct(ctx, request)
+		if errList != nil {
+			log.FromCtx(ctx).Error(errList, "failed get list product")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if len(listProduct) > 0 {
+			listProductGUID := make([]string, len(listProduct))
+			for i := range listProduct {
+				listProductGUID[i] = listProduct[i].Guid
+			}
+
+			listCategory, errCategory := q.ListProductCategoryByProducts(ctx, listProductGUID)
+			if errCategory != nil {
+				log.FromCtx(ctx).Error(errCategory, "failed get list product category")
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+
+			if err = writePage(listProduct, listCategory); err != nil {
+				return
+			}
+		}
+
+		if len(listProduct) < int(request.LimitData) {
+			return
+		}
+	}
+}
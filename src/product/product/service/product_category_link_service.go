@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// replaceProductCategory swaps the categories linked to a product for the given ones.
+// Every category has to exist and be active.
+func replaceProductCategory(ctx context.Context, q *sqlc.Queries, productGUID string, listCategoryGUID []string, userGUID string) (listCategory []sqlc.ListProductCategoryByProductRow, err error) {
+	for i := range listCategoryGUID {
+		category, errCategory := q.GetProductCategory(ctx, listCategoryGUID[i])
+		if errCategory != nil {
+			log.FromCtx(ctx).Error(errCategory, "failed get product category")
+			err = errors.Wrapf(httpservice.ErrProductCategoryNotFound, "product category %s not found", listCategoryGUID[i])
+
+			return
+		}
+
+		if category.DeletedAt.Valid {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: product category %s is inactive", listCategoryGUID[i])
+			return
+		}
+	}
+
+	if err = q.DeleteProductCategoryLinkByProduct(ctx, productGUID); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete product category link")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listCategoryGUID {
+		if err = q.InsertProductCategoryLink(ctx, sqlc.InsertProductCategoryLinkParams{
+			ProductGuid:         productGUID,
+			ProductCategoryGuid: listCategoryGUID[i],
+			CreatedBy:           userGUID,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert product category link")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	return listProductCategory(ctx, q, productGUID)
+}
+
+func listProductCategory(ctx context.Context, q *sqlc.Queries, productGUID string) (listCategory []sqlc.ListProductCategoryByProductRow, err error) {
+	listCategory, err = q.ListProductCategoryByProduct(ctx, productGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product category")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ReplaceProductComponent sets the bill of materials of a kit. Bills of materials are one
+// level deep: a kit cannot be the component of another kit and its components cannot be kits
+// themselves. Serialized products are not kitted since assembly does not scan serials.
+func (s *ProductService) ReplaceProductComponent(ctx context.Context, productGUID string, listComponent []sqlc.InsertProductComponentParams) (listComponentData []sqlc.ListProductComponentByProductRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if len(listComponent) > 0 {
+		if err = validateKit(ctx, q, productGUID); err != nil {
+			return
+		}
+	}
+
+	for i := range listComponent {
+		if err = validateComponent(ctx, q, productGUID, listComponent[i].ComponentProductGuid); err != nil {
+			return
+		}
+	}
+
+	if err = q.DeleteProductComponentByProduct(ctx, productGUID); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete product component")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listComponent {
+		if _, err = q.InsertProductComponent(ctx, listComponent[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert product component")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	listComponentData, err = listProductComponent(ctx, q, productGUID)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *ProductService) ListProductComponent(ctx context.Context, productGUID string) (listComponent []sqlc.ListProductComponentByProductRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetProduct(ctx, productGUID); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	return listProductComponent(ctx, q, productGUID)
+}
+
+func listProductComponent(ctx context.Context, q *sqlc.Queries, productGUID string) (listComponent []sqlc.ListProductComponentByProductRow, err error) {
+	listComponent, err = q.ListProductComponentByProduct(ctx, productGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product component")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func validateKit(ctx context.Context, q *sqlc.Queries, productGUID string) (err error) {
+	product, err := q.GetProduct(ctx, productGUID)
+	if err != nil || product.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	if product.IsSerialized {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: a serialized product cannot be a kit")
+		return
+	}
+
+	totalUsed, err := q.GetCountProductComponentByComponent(ctx, productGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get count product component")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if totalUsed > 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product is a component of another kit")
+		return
+	}
+
+	return
+}
+
+func validateComponent(ctx context.Context, q *sqlc.Queries, productGUID string, componentGUID string) (err error) {
+	if componentGUID == productGUID {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: a kit cannot be its own component")
+		return
+	}
+
+	component, err := q.GetProduct(ctx, componentGUID)
+	if err != nil || component.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.Wrapf(httpservice.ErrProductNotFound, "component %s", componentGUID)
+
+		return
+	}
+
+	if component.IsSerialized {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: serialized product %s cannot be a component", componentGUID)
+		return
+	}
+
+	totalComponent, err := q.GetCountProductComponentByProduct(ctx, componentGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get count product component")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if totalComponent > 0 {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: component %s is a kit itself", componentGUID)
+		return
+	}
+
+	return
+}
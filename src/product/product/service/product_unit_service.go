@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// validateProductSku makes sure no other product already uses the sku.
+func validateProductSku(ctx context.Context, q *sqlc.Queries, sku sql.NullString, productGUID string) (err error) {
+	guid, err := q.GetProductGuidBySku(ctx, sku)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get product by sku")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if guid != productGUID {
+		err = errors.Wrapf(httpservice.ErrDuplicateProductSku, "sku %s is already used by another product", sku.String)
+		return
+	}
+
+	return
+}
+
+func replaceProductUnit(ctx context.Context, q *sqlc.Queries, productGUID string, listUnit []sqlc.InsertProductUnitParams) (err error) {
+	if err = q.DeleteProductUnitByProduct(ctx, productGUID); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete product unit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listUnit {
+		listUnit[i].ProductGuid = productGUID
+
+		if _, err = q.InsertProductUnit(ctx, listUnit[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert product unit")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	return
+}
+
+// replaceProductBarcode swaps the barcodes of a product. A barcode may only belong to one product.
+func replaceProductBarcode(ctx context.Context, q *sqlc.Queries, productGUID string, listBarcode []sqlc.InsertProductBarcodeParams) (err error) {
+	if err = q.DeleteProductBarcodeByProduct(ctx, productGUID); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete product barcode")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listBarcode {
+		_, errBarcode := q.GetProductBarcode(ctx, listBarcode[i].Barcode)
+		if errBarcode == nil {
+			err = errors.Wrapf(httpservice.ErrDuplicateProductBarcode, "barcode %s is already used by another product", listBarcode[i].Barcode)
+			return
+		}
+
+		if !errors.Is(errBarcode, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(errBarcode, "failed get product barcode")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		listBarcode[i].ProductGuid = productGUID
+
+		if _, err = q.InsertProductBarcode(ctx, listBarcode[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert product barcode")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	return
+}
+
+// listProductBarcodeUnit loads the barcodes and units of a product and checks that no unit
+// repeats the base unit and every barcode refers to the base unit or one of the configured units.
+func listProductBarcodeUnit(ctx context.Context, q *sqlc.Queries, productGUID string, baseUnit string) (listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit, err error) {
+	listUnit, err = q.ListProductUnitByProduct(ctx, productGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product unit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listBarcode, err = q.ListProductBarcodeByProduct(ctx, productGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product barcode")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	unitSet := map[string]bool{baseUnit: true}
+	for i := range listUnit {
+		if listUnit[i].Unit == baseUnit {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: unit %s is the base unit of the product", listUnit[i].Unit)
+			return
+		}
+
+		unitSet[listUnit[i].Unit] = true
+	}
+
+	for i := range listBarcode {
+		if listBarcode[i].Unit.Valid && !unitSet[listBarcode[i].Unit.String] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: unit %s of barcode %s is not configured for the product", listBarcode[i].Unit.String, listBarcode[i].Barcode)
+			return
+		}
+	}
+
+	return
+}
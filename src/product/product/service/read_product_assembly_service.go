@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *ProductService) ListProductAssembly(ctx context.Context, request sqlc.ListProductAssemblyParams) (listAssembly []sqlc.ListProductAssemblyRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountProductAssembly(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listAssembly, err = q.ListProductAssembly(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product assembly")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *ProductService) GetProductAssembly(ctx context.Context, guid string) (assembly sqlc.GetProductAssemblyRow, listMovement []sqlc.ProductsHistory, err error) {
+	q := sqlc.New(s.mainDB)
+
+	return getProductAssemblyWithMovements(ctx, q, guid)
+}
+
+func (s *ProductService) getCountProductAssembly(ctx context.Context, q *sqlc.Queries, request sqlc.ListProductAssemblyParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountProductAssemblyParams{
+		SetProduct:    request.SetProduct,
+		ProductGuid:   request.ProductGuid,
+		SetWarehouse:  request.SetWarehouse,
+		WarehouseGuid: request.WarehouseGuid,
+		SetOperation:  request.SetOperation,
+		Operation:     request.Operation,
+	}
+
+	totalData, err = q.GetCountProductAssembly(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list product assembly")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getProductAssemblyWithMovements(ctx context.Context, q *sqlc.Queries, guid string) (assembly sqlc.GetProductAssemblyRow, listMovement []sqlc.ProductsHistory, err error) {
+	assembly, err = q.GetProductAssembly(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrProductAssemblyNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get product assembly")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listMovement, err = q.ListProductsHistoryByReference(ctx, sqlc.ListProductsHistoryByReferenceParams{
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceAssembly, Valid: true},
+		ReferenceGuid: sql.NullString{String: guid, Valid: true},
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -2,13 +2,14 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"github.com/pkg/errors"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
 	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
 	"github.com/wit-id/blueprint-backend-go/toolkit/log"
 )
 
-func (s *ProductService) ListProduct(ctx context.Context, request sqlc.ListProductParams) (listProduct []sqlc.ListProductRow, totalData int64, err error) {
+func (s *ProductService) ListProduct(ctx context.Context, request sqlc.ListProductParams) (listProduct []sqlc.ListProductRow, listCategory []sqlc.ListProductCategoryByProductsRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit, totalData int64, err error) {
 	q := sqlc.New(s.mainDB)
 
 	// Get Total data
@@ -25,10 +26,39 @@ func (s *ProductService) ListProduct(ctx context.Context, request sqlc.ListProdu
 		return
 	}
 
+	listProductGUID := make([]string, len(listProduct))
+	for i := range listProduct {
+		listProductGUID[i] = listProduct[i].Guid
+	}
+
+	listCategory, err = q.ListProductCategoryByProducts(ctx, listProductGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product category")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listBarcode, err = q.ListProductBarcodeByProducts(ctx, listProductGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product barcode")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listUnit, err = q.ListProductUnitByProducts(ctx, listProductGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product unit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
 	return
 }
 
-func (s *ProductService) GetProduct(ctx context.Context, guid string) (product sqlc.GetProductRow, err error) {
+func (s *ProductService) GetProduct(ctx context.Context, guid string) (product sqlc.GetProductRow, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit, err error) {
 	q := sqlc.New(s.mainDB)
 
 	product, err = q.GetProduct(ctx, guid)
@@ -39,13 +69,62 @@ func (s *ProductService) GetProduct(ctx context.Context, guid string) (product s
 		return
 	}
 
+	listCategory, err = listProductCategory(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	listBarcode, listUnit, err = listProductBarcodeUnit(ctx, q, guid, product.BaseUnit)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetProductBarcode finds the product a scanned barcode belongs to, together with how many
+// base units the barcode unit holds.
+func (s *ProductService) GetProductBarcode(ctx context.Context, barcode string) (productBarcode sqlc.ProductBarcode, conversionFactor int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	productBarcode, err = q.GetProductBarcode(ctx, barcode)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product barcode")
+		err = errors.WithStack(httpservice.ErrProductBarcodeNotFound)
+
+		return
+	}
+
+	conversionFactor = 1
+
+	if productBarcode.Unit.Valid {
+		productUnit, errUnit := q.GetProductUnit(ctx, sqlc.GetProductUnitParams{
+			ProductGuid: productBarcode.ProductGuid,
+			Unit:        productBarcode.Unit.String,
+		})
+		if errUnit != nil && !errors.Is(errUnit, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(errUnit, "failed get product unit")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		// A barcode on the base unit has no unit row
+		if errUnit == nil {
+			conversionFactor = productUnit.ConversionFactor
+		}
+	}
+
 	return
 }
 
 func (s *ProductService) getCountProduct(ctx context.Context, q *sqlc.Queries, request sqlc.ListProductParams) (totalData int64, err error) {
 	requestQueryParams := sqlc.GetCountProductListParams{
-		SetName: request.SetName,
-		Name:    request.Name,
+		SetName:            request.SetName,
+		Name:               request.Name,
+		SetCategory:        request.SetCategory,
+		CategoryGuid:       request.CategoryGuid,
+		IncludeSubCategory: request.IncludeSubCategory,
 	}
 
 	totalData, err = q.GetCountProductList(ctx, requestQueryParams)
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *ProductService) ListProductStock(ctx context.Context, request sqlc.ListStockBalanceByProductParams) (listStock []sqlc.ListStockBalanceByProductRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetProduct(ctx, request.ProductGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	totalData, err = q.GetCountStockBalanceByProduct(ctx, request.ProductGuid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data product stock")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listStock, err = q.ListStockBalanceByProduct(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product stock")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *ProductService) ListProductStockLocation(ctx context.Context, request sqlc.ListStockLocationBalanceByProductParams) (listStock []sqlc.ListStockLocationBalanceByProductRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetProduct(ctx, request.ProductGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	totalData, err = q.GetCountStockLocationBalanceByProduct(ctx, request.ProductGuid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data product stock location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listStock, err = q.ListStockLocationBalanceByProduct(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product stock location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
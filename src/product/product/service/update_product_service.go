@@ -9,7 +9,7 @@ import (
 	"github.com/wit-id/blueprint-backend-go/toolkit/log"
 )
 
-func (s *ProductService) UpdateProduct(ctx context.Context, request sqlc.UpdateProductParams) (product sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow, err error) {
+func (s *ProductService) UpdateProduct(ctx context.Context, request sqlc.UpdateProductParams, listCategoryGUID []string, listBarcodeRequest []sqlc.InsertProductBarcodeParams, listUnitRequest []sqlc.InsertProductUnitParams) (product sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit, err error) {
 	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		log.FromCtx(ctx).Error(err, "failed begin tx")
@@ -39,6 +39,48 @@ func (s *ProductService) UpdateProduct(ctx context.Context, request sqlc.UpdateP
 		return
 	}
 
+	currentProduct, err := q.GetProduct(ctx, request.Guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	// Omitted fields keep what is stored
+	if request.BaseUnit == "" {
+		request.BaseUnit = currentProduct.BaseUnit
+	}
+
+	if request.CostingMethod == "" {
+		request.CostingMethod = currentProduct.CostingMethod
+	}
+
+	// Switching serial tracking on or off would leave units on hand with or without serials,
+	// a new base unit would change the meaning of every quantity on hand, and average cost
+	// products keep their cost layers at layer cost so the layers no longer add up to the valuation
+	if currentProduct.IsSerialized != request.IsSerialized || currentProduct.BaseUnit != request.BaseUnit ||
+		currentProduct.CostingMethod != request.CostingMethod {
+		var totalStock int64
+
+		totalStock, err = q.GetSumStockBalanceByProduct(ctx, request.Guid)
+		if err != nil {
+			log.FromCtx(ctx).Error(err, "failed get total stock product")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if totalStock > 0 {
+			err = errors.Wrap(httpservice.ErrProductHasStock, "serial tracking, base unit and costing method can only be changed when the product has no stock")
+			return
+		}
+	}
+
+	if err = validateProductSku(ctx, q, request.Sku, request.Guid); err != nil {
+		return
+	}
+
 	product, err = q.UpdateProduct(ctx, request)
 	if err != nil {
 		log.FromCtx(ctx).Error(err, "failed update product")
@@ -47,6 +89,34 @@ func (s *ProductService) UpdateProduct(ctx context.Context, request sqlc.UpdateP
 		return
 	}
 
+	// A nil list keeps the current categories, an empty one clears them
+	if listCategoryGUID != nil {
+		listCategory, err = replaceProductCategory(ctx, q, product.Guid, listCategoryGUID, request.UpdatedBy.String)
+	} else {
+		listCategory, err = listProductCategory(ctx, q, product.Guid)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if listUnitRequest != nil {
+		if err = replaceProductUnit(ctx, q, product.Guid, listUnitRequest); err != nil {
+			return
+		}
+	}
+
+	if listBarcodeRequest != nil {
+		if err = replaceProductBarcode(ctx, q, product.Guid, listBarcodeRequest); err != nil {
+			return
+		}
+	}
+
+	listBarcode, listUnit, err = listProductBarcodeUnit(ctx, q, product.Guid, product.BaseUnit)
+	if err != nil {
+		return
+	}
+
 	if err = tx.Commit(); err != nil {
 		log.FromCtx(ctx).Error(err, "error commit")
 		err = errors.WithStack(httpservice.ErrUnknownSource)
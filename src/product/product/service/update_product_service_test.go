@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/product/product/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+)
+
+func TestProductService_UpdateProductStockGuard(t *testing.T) {
+	userColumns := []string{"id", "guid", "name", "profile_picture_image_url", "phone", "email", "role_id", "password", "salt",
+		"is_active", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by", "last_login",
+		"role_name", "role_access", "is_all_access"}
+	productColumns := []string{"guid", "name", "product_picture_url", "description", "created_at", "created_by", "updated_at",
+		"updated_by", "deleted_at", "deleted_by", "is_serialized", "sku", "base_unit", "costing_method", "user_name", "user_id",
+		"user_name_update", "user_id_update"}
+	now := time.Now()
+
+	// The stored product is a fifo product counted in pcs without serials
+	tests := []struct {
+		name           string
+		costingMethod  string
+		baseUnit       string
+		isSerialized   bool
+		wantStockCheck bool
+		totalStock     int64
+		wantHasStock   bool
+	}{
+		{
+			name:           "rejects switching to average cost while stock is on hand",
+			costingMethod:  constants.ProductCostingMethodAverage,
+			wantStockCheck: true,
+			totalStock:     5,
+			wantHasStock:   true,
+		},
+		{
+			name:           "switches to average cost once the stock is gone",
+			costingMethod:  constants.ProductCostingMethodAverage,
+			wantStockCheck: true,
+		},
+		{
+			name:           "rejects a new base unit while stock is on hand",
+			baseUnit:       "box",
+			wantStockCheck: true,
+			totalStock:     5,
+			wantHasStock:   true,
+		},
+		{
+			name:           "rejects switching on serial tracking while stock is on hand",
+			isSerialized:   true,
+			wantStockCheck: true,
+			totalStock:     5,
+			wantHasStock:   true,
+		},
+		{
+			name: "keeps the stored costing method and base unit when omitted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("-- name: GetUserBackoffice").WillReturnRows(sqlmock.NewRows(userColumns).
+				AddRow(1, "user-1", nil, nil, "", "", 1, "", "", nil, now, "", nil, nil, nil, nil, nil, "admin", nil, nil))
+			mock.ExpectQuery("-- name: GetProduct").WillReturnRows(sqlmock.NewRows(productColumns).
+				AddRow("product-1", nil, nil, "", now, "user-1", nil, nil, nil, nil, false, nil, "pcs",
+					constants.ProductCostingMethodFIFO, nil, nil, nil, nil))
+
+			if tt.wantStockCheck {
+				mock.ExpectQuery("-- name: GetSumStockBalanceByProduct").WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(tt.totalStock))
+			}
+
+			// Anything past the guard is out of scope here, the next query fails and rolls back
+			mock.ExpectRollback()
+
+			s := service.NewProductService(db, viper.New())
+
+			_, _, _, _, _, err = s.UpdateProduct(context.Background(), sqlc.UpdateProductParams{
+				Guid:          "product-1",
+				IsSerialized:  tt.isSerialized,
+				BaseUnit:      tt.baseUnit,
+				CostingMethod: tt.costingMethod,
+				UpdatedBy:     sql.NullString{String: "user-1", Valid: true},
+			}, nil, nil, nil)
+
+			if gotHasStock := errors.Is(err, httpservice.ErrProductHasStock); gotHasStock != tt.wantHasStock {
+				t.Errorf("UpdateProduct() error = %v, want product has stock %v", err, tt.wantHasStock)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
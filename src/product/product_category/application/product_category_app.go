@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/wit-id/blueprint-backend-go/common/constants"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
 	"github.com/wit-id/blueprint-backend-go/src/middleware"
 	"github.com/wit-id/blueprint-backend-go/src/product/product_category/service"
 	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
@@ -26,9 +27,12 @@ func AddRouteProductCategory(s *httpservice.Service, cfg config.KVStore, e *echo
 	})
 
 	product.POST("", listProductCategory(svc))
+	product.GET("/tree", getProductCategoryTree(svc))
 	product.GET("/:guid", getProductCategory(svc))
 	product.POST("/create", createProductCategory(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.POST("/import", importProductCategory(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	product.PUT("/:guid", updateProductCategory(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	product.PUT("/:guid/move", moveProductCategory(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	product.DELETE("/:guid", deleteProductCategory(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	product.GET("/reactive/:guid", reactiveProductCategory(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 }
@@ -55,6 +59,65 @@ func createProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc
 	}
 }
 
+func importProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ImportPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		fileHeader, err := ctx.FormFile("file")
+		if err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to get import file")
+			return errors.Wrap(httpservice.ErrBadRequest, "bad request: file is required")
+		}
+
+		listRow, err := utility.ReadImportFormFile(fileHeader)
+		if err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		// Validate every row, only the valid ones reach the service
+		rowErrors := make(map[int]error)
+		listLine := make([]int, 0, len(listRow))
+		listProductCategory := make([]service.ProductCategoryImport, 0, len(listRow))
+
+		for i := range listRow {
+			var productCategory payload.RegisterProductCategoryPayload
+			if err = productCategory.FromImportRow(listRow[i]); err == nil {
+				err = productCategory.Validate()
+			}
+
+			if err != nil {
+				rowErrors[listRow[i].Line] = err
+				continue
+			}
+
+			listLine = append(listLine, listRow[i].Line)
+			listProductCategory = append(listProductCategory, service.ProductCategoryImport{
+				Category:   productCategory.ToEntity(userData),
+				ParentName: listRow[i].Get("parent_name"),
+			})
+		}
+
+		listError, err := svc.ImportProductCategory(ctx.Request().Context(), listProductCategory, request.DryRun)
+		if err != nil {
+			return err
+		}
+
+		for i := range listError {
+			if listError[i] != nil {
+				rowErrors[listLine[i]] = listError[i]
+			}
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadImport(request.DryRun, len(listRow), rowErrors), nil)
+	}
+}
+
 func updateProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		guid := ctx.Param("guid")
@@ -82,6 +145,29 @@ func updateProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc
 	}
 }
 
+func moveProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.MoveProductCategoryPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+		data, err := svc.MoveProductCategory(ctx.Request().Context(), guid, request.ParentID, userBackoffice)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadUpdateProductCategory(data, userBackoffice), nil)
+	}
+}
+
 func deleteProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		guid := ctx.Param("guid")
@@ -116,6 +202,17 @@ func getProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc {
 	}
 }
 
+func getProductCategoryTree(svc *service.ProductCategoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		listData, err := svc.ListProductCategoryTree(ctx.Request().Context())
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductCategoryTree(listData), nil)
+	}
+}
+
 func reactiveProductCategory(svc *service.ProductCategoryService) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		guid := ctx.Param("guid")
@@ -39,6 +39,28 @@ func (s *ProductCategoryService) CreateProductCategory(ctx context.Context, requ
 		return
 	}
 
+	productCategory, err = createProductCategory(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func createProductCategory(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductCategoryParams) (productCategory sqlc.ProductCategory, err error) {
+	if request.ParentGuid.Valid {
+		if err = validateParentProductCategory(ctx, q, request.ParentGuid.String); err != nil {
+			return
+		}
+	}
+
 	productCategory, err = q.InsertProductCategory(ctx, request)
 	if err != nil {
 		log.FromCtx(ctx).Error(err, "failed insert product category")
@@ -47,10 +69,21 @@ func (s *ProductCategoryService) CreateProductCategory(ctx context.Context, requ
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.FromCtx(ctx).Error(err, "error commit")
-		err = errors.WithStack(httpservice.ErrUnknownSource)
+	return
+}
+
+// validateParentProductCategory makes sure a category can be placed under the given parent.
+func validateParentProductCategory(ctx context.Context, q *sqlc.Queries, parentGUID string) (err error) {
+	parent, err := q.GetProductCategory(ctx, parentGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get parent product category")
+		err = errors.Wrap(httpservice.ErrProductCategoryNotFound, "parent product category not found")
+
+		return
+	}
 
+	if parent.DeletedAt.Valid {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: parent product category is not active")
 		return
 	}
 
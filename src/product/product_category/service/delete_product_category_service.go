@@ -31,6 +31,40 @@ func (s *ProductCategoryService) DeleteProductCategory(ctx context.Context, guid
 		}
 	}()
 
+	productCategory, err := q.GetProductCategory(ctx, guid)
+	if err != nil || productCategory.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product category")
+		err = errors.WithStack(httpservice.ErrProductCategoryNotFound)
+
+		return
+	}
+
+	totalChild, err := q.GetCountActiveChildProductCategory(ctx, sql.NullString{String: guid, Valid: true})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total child product category")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if totalChild > 0 {
+		err = errors.Wrapf(httpservice.ErrProductCategoryInUse, "product category still has %d active sub categories", totalChild)
+		return
+	}
+
+	totalProduct, err := q.GetCountActiveProductByCategory(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total product by category")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if totalProduct > 0 {
+		err = errors.Wrapf(httpservice.ErrProductCategoryInUse, "product category still has %d active products", totalProduct)
+		return
+	}
+
 	if err = q.DeleteProductCategory(ctx, sqlc.DeleteProductCategoryParams{
 		UpdatedBy: sql.NullString{
 			String: userData.Guid,
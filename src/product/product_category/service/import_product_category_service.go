@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"strings"
+)
+
+// ProductCategoryImport is one row of a category import. ParentName refers to a category on an
+// earlier row of the same file, so a whole tree can be imported before any of it has an id.
+type ProductCategoryImport struct {
+	Category   sqlc.InsertProductCategoryParams
+	ParentName string
+}
+
+// ImportProductCategory creates the categories of an import file in a single transaction. A
+// rejected row is rolled back on its own and its reason returned at the same index of listError,
+// the valid rows are committed. A dry run checks every row the same way and then rolls back.
+func (s *ProductCategoryService) ImportProductCategory(ctx context.Context, listRequest []ProductCategoryImport, dryRun bool) (listError []error, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	listError = make([]error, len(listRequest))
+
+	// Categories created by this import by lower cased name, for the parent_name column
+	importedGUID := make(map[string]string, len(listRequest))
+
+	for i := range listRequest {
+		request := listRequest[i]
+
+		listError[i], err = utility.ImportInSavepoint(ctx, tx, func() (errRow error) {
+			if request.ParentName != "" {
+				if request.Category.ParentGuid.Valid {
+					return errors.Wrap(httpservice.ErrBadRequest, "bad request: set either parent_id or parent_name")
+				}
+
+				parentGUID, ok := importedGUID[strings.ToLower(request.ParentName)]
+				if !ok {
+					return errors.Wrapf(httpservice.ErrBadRequest, "bad request: parent category %q is not imported on an earlier row", request.ParentName)
+				}
+
+				request.Category.ParentGuid = sql.NullString{String: parentGUID, Valid: true}
+			}
+
+			_, errRow = createProductCategory(ctx, q, request.Category)
+
+			return
+		})
+		if err != nil {
+			return
+		}
+
+		if listError[i] == nil {
+			importedGUID[strings.ToLower(request.Category.Name)] = request.Category.Guid
+		}
+	}
+
+	if dryRun {
+		if err = tx.Rollback(); err != nil {
+			log.FromCtx(ctx).Error(err, "error rollback")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// MoveProductCategory re-parents a category together with its whole subtree. An empty
+// parent moves the category to the root.
+func (s *ProductCategoryService) MoveProductCategory(ctx context.Context, guid string, parentGUID string, userData sqlc.GetUserBackofficeRow) (productCategory sqlc.ProductCategory, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	current, err := q.GetProductCategory(ctx, guid)
+	if err != nil || current.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product category")
+		err = errors.WithStack(httpservice.ErrProductCategoryNotFound)
+
+		return
+	}
+
+	if parentGUID != "" {
+		if parentGUID == guid {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product category cannot be its own parent")
+			return
+		}
+
+		// Concurrent moves each pass the descendant check on their own snapshot and can still
+		// close a cycle together, so moves under a parent take turns on the whole tree
+		if err = q.LockProductCategoryTree(ctx); err != nil {
+			log.FromCtx(ctx).Error(err, "failed lock product category tree")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if err = validateParentProductCategory(ctx, q, parentGUID); err != nil {
+			return
+		}
+
+		// Moving a category under one of its descendants would cut the subtree off into a cycle
+		var listDescendant []string
+
+		listDescendant, err = q.ListProductCategoryDescendant(ctx, sql.NullString{String: guid, Valid: true})
+		if err != nil {
+			log.FromCtx(ctx).Error(err, "failed get product category descendant")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		for i := range listDescendant {
+			if listDescendant[i] == parentGUID {
+				err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product category cannot be moved under its own descendant")
+				return
+			}
+		}
+	}
+
+	productCategory, err = q.UpdateProductCategoryParent(ctx, sqlc.UpdateProductCategoryParentParams{
+		ParentGuid: sql.NullString{
+			String: parentGUID,
+			Valid:  parentGUID != "",
+		},
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid: guid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed update product category parent")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
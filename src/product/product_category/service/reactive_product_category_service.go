@@ -31,6 +31,20 @@ func (s *ProductCategoryService) ReactiveProductCategory(ctx context.Context, gu
 		}
 	}()
 
+	productCategory, err := q.GetProductCategory(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product category")
+		err = errors.WithStack(httpservice.ErrProductCategoryNotFound)
+
+		return
+	}
+
+	if productCategory.ParentGuid.Valid {
+		if err = validateParentProductCategory(ctx, q, productCategory.ParentGuid.String); err != nil {
+			return
+		}
+	}
+
 	if err = q.ReactiveProductCategory(ctx, sqlc.ReactiveProductCategoryParams{
 		UpdatedBy: sql.NullString{
 			String: userData.Guid,
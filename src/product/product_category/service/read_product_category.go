@@ -38,12 +38,27 @@ func (s *ProductCategoryService) GetProductCategory(ctx context.Context, guid st
 	return
 }
 
+func (s *ProductCategoryService) ListProductCategoryTree(ctx context.Context) (listProductCategory []sqlc.ListProductCategoryTreeRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	listProductCategory, err = q.ListProductCategoryTree(ctx)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product category tree")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+	}
+
+	return
+}
+
 func (s *ProductCategoryService) getProductCategoryCount(ctx context.Context, q *sqlc.Queries, request sqlc.ListProductCategoryParams) (totalData int64, err error) {
 	requestQueryParams := sqlc.GetCountListProductCategoryParams{
-		SetName:   request.SetName,
-		Name:      request.Name,
-		SetActive: request.SetActive,
-		Active:    request.Active,
+		SetName:            request.SetName,
+		Name:               request.Name,
+		SetActive:          request.SetActive,
+		Active:             request.Active,
+		SetParent:          request.SetParent,
+		ParentGuid:         request.ParentGuid,
+		IncludeDescendants: request.IncludeDescendants,
 	}
 
 	totalData, err = q.GetCountListProductCategory(ctx, requestQueryParams)
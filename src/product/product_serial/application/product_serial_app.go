@@ -0,0 +1,41 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/product/product_serial/service"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"net/http"
+)
+
+func AddRouteProductSerial(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewProductSerialService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	productSerial := e.Group("/serial")
+	productSerial.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "product serial ok")
+	})
+
+	productSerial.GET("/:serial", getProductSerial(svc), mddw.ValidateToken)
+}
+
+func getProductSerial(svc *service.ProductSerialService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		serialNumber := ctx.Param("serial")
+		if serialNumber == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listHistory, err := svc.GetProductSerial(ctx.Request().Context(), serialNumber)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductSerial(data, listHistory), nil)
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *ProductSerialService) GetProductSerial(ctx context.Context, serialNumber string) (productSerial sqlc.ProductSerial, listHistory []sqlc.ListProductSerialHistoryRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	productSerial, err = q.GetProductSerial(ctx, serialNumber)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product serial")
+		err = errors.WithStack(httpservice.ErrProductSerialNotFound)
+
+		return
+	}
+
+	listHistory, err = q.ListProductSerialHistory(ctx, serialNumber)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product serial history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type ProductSerialService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewProductSerialService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *ProductSerialService {
+	return &ProductSerialService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
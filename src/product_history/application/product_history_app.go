@@ -0,0 +1,208 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	exportService "github.com/wit-id/blueprint-backend-go/src/export/service"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteProductHistory(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewProductHistoryService(s.GetDB(), cfg)
+	exportSvc := exportService.NewExportService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	productHistory := e.Group("/product-history")
+	productHistory.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "product history ok")
+	})
+	productHistory.Use(mddw.ValidateToken)
+	productHistory.Use(mddw.ValidateUserHandheldLogin)
+
+	productHistory.POST("/masuk", createProductHistoryMasukHandheld(svc))
+	productHistory.POST("/keluar", createProductHistoryKeluarHandheld(svc))
+	productHistory.POST("/list", listProductHistory(svc))
+	productHistory.GET("/:guid", getProductHistory(svc))
+
+	productHistoryBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "product-history")
+	productHistoryBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "product history ok")
+	})
+	productHistoryBO.Use(mddw.ValidateToken)
+	productHistoryBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	productHistoryBO.POST("/masuk", createProductHistoryMasukBackoffice(svc))
+	productHistoryBO.POST("/keluar", createProductHistoryKeluarBackoffice(svc))
+	productHistoryBO.POST("/list", listProductHistory(svc))
+	productHistoryBO.POST("/export", exportProductHistory(svc, exportSvc, cfg))
+	productHistoryBO.GET("/:guid", getProductHistory(svc))
+}
+
+func createProductHistoryMasukHandheld(svc *service.ProductHistoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		return createProductHistoryMasuk(ctx, svc, userHandheld.Guid)
+	}
+}
+
+func createProductHistoryMasukBackoffice(svc *service.ProductHistoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		return createProductHistoryMasuk(ctx, svc, userBackoffice.Guid)
+	}
+}
+
+func createProductHistoryKeluarHandheld(svc *service.ProductHistoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		return createProductHistoryKeluar(ctx, svc, userHandheld.Guid)
+	}
+}
+
+func createProductHistoryKeluarBackoffice(svc *service.ProductHistoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		return createProductHistoryKeluar(ctx, svc, userBackoffice.Guid)
+	}
+}
+
+func createProductHistoryMasuk(ctx echo.Context, svc *service.ProductHistoryService, userGUID string) error {
+	var request payload.InsertProductHistoryMasukPayload
+	if err := ctx.Bind(&request); err != nil {
+		log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+		return errors.WithStack(httpservice.ErrBadRequest)
+	}
+
+	// Validate request
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	data, err := svc.CreateProductHistoryMasuk(ctx.Request().Context(), request.ToEntity(userGUID), request.SerialNumbers)
+	if err != nil {
+		return err
+	}
+
+	return httpservice.ResponseData(ctx, payload.ToPayloadProductHistory(data), nil)
+}
+
+func createProductHistoryKeluar(ctx echo.Context, svc *service.ProductHistoryService, userGUID string) error {
+	var request payload.InsertProductHistoryKeluarPayload
+	if err := ctx.Bind(&request); err != nil {
+		log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+		return errors.WithStack(httpservice.ErrBadRequest)
+	}
+
+	// Validate request
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	data, err := svc.CreateProductHistoryKeluar(ctx.Request().Context(), request.ToEntity(userGUID), request.SerialNumbers)
+	if err != nil {
+		return err
+	}
+
+	return httpservice.ResponseData(ctx, payload.ToPayloadProductHistory(data), nil)
+}
+
+func listProductHistory(svc *service.ProductHistoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListProductHistoryPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListProductHistory(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProductHistory(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getProductHistory(svc *service.ProductHistoryService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetProductHistory(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductHistory(data), nil)
+	}
+}
+
+func exportProductHistory(svc *service.ProductHistoryService, exportSvc *exportService.ExportService, cfg config.KVStore) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ExportProductHistoryPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+		listRequest := request.ToEntity()
+
+		totalData, err := svc.GetCountProductHistory(ctx.Request().Context(), listRequest)
+		if err != nil {
+			return err
+		}
+
+		exportRequest := payload.ToEntityExportFile(constants.ExportTypeProductHistory, request.Format, totalData, userData)
+
+		content, exportFile, err := exportSvc.Export(ctx.Request().Context(), exportRequest, listRequest, payload.ExportHeaderProductHistory, func(renderCtx context.Context, writer utility.ExportWriter) error {
+			return svc.ExportProductHistory(renderCtx, listRequest, func(listProductHistory []sqlc.ProductsHistory) error {
+				return utility.WriteExportRows(writer, payload.ToExportRowsProductHistory(listProductHistory))
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		// A large export is rendered in the background, answer with where to pick it up
+		if exportFile.Guid != "" {
+			return httpservice.ResponseData(ctx, payload.ToPayloadExportFile(exportFile, cfg.GetString(constants.ConfigPrefixRoutesBackoffice)), nil)
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", exportRequest.FileName))
+
+		return ctx.Blob(http.StatusOK, utility.ExportContentType(request.Format), content)
+	}
+}
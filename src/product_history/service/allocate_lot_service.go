@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"time"
+)
+
+// AllocateLotFEFO spreads quantity over lots that are already sorted first-expiry-first-out.
+// Each returned lot carries the quantity taken from it; anything the lots cannot cover is
+// left to untracked stock. Lots that expired before the day of asOf are skipped, expired
+// stock only leaves by naming its lot. A zero asOf keeps every lot.
+func AllocateLotFEFO(listLot []sqlc.StockLotBalance, quantity int64, asOf time.Time) (allocation []sqlc.StockLotBalance) {
+	today := asOf.UTC().Truncate(24 * time.Hour)
+
+	for _, lot := range listLot {
+		if quantity <= 0 {
+			break
+		}
+
+		if !asOf.IsZero() && lot.ExpiryDate.Valid && lot.ExpiryDate.Time.Before(today) {
+			continue
+		}
+
+		take := lot.Quantity
+		if take > quantity {
+			take = quantity
+		}
+
+		lot.Quantity = take
+		allocation = append(allocation, lot)
+		quantity -= take
+	}
+
+	return
+}
+
+// increaseStockLot adds an inbound movement to its lot, a known lot keeps its expiry date.
+func increaseStockLot(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductsHistoryParams) (err error) {
+	lot, err := q.IncreaseStockLotBalance(ctx, sqlc.IncreaseStockLotBalanceParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+		LotNumber:     request.LotNumber.String,
+		ExpiryDate:    request.ExpiryDate,
+		Quantity:      request.Quantity,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed increase stock lot balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if request.ExpiryDate.Valid && !lot.ExpiryDate.Time.Equal(request.ExpiryDate.Time) {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: lot %s is already recorded with expiry date %s", lot.LotNumber, lot.ExpiryDate.Time.Format("2006-01-02"))
+		return
+	}
+
+	return
+}
+
+// decreaseStockLot takes an outbound movement out of the requested lot, or out of the
+// earliest expiring lots when the movement does not name one.
+func decreaseStockLot(ctx context.Context, q *sqlc.Queries, request sqlc.InsertKeluarProductsHistoryParams) (err error) {
+	if request.LotNumber.Valid {
+		if _, err = q.DecreaseStockLotBalance(ctx, sqlc.DecreaseStockLotBalanceParams{
+			Quantity:      request.Quantity,
+			ProductGuid:   request.ProductGuid,
+			WarehouseGuid: request.WarehouseGuid,
+			LotNumber:     request.LotNumber.String,
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.WithStack(httpservice.ErrInsufficientStock)
+
+				return
+			}
+
+			log.FromCtx(ctx).Error(err, "failed decrease stock lot balance")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		return
+	}
+
+	listLot, err := q.ListStockLotBalanceFEFOForUpdate(ctx, sqlc.ListStockLotBalanceFEFOForUpdateParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock lot balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for _, lot := range AllocateLotFEFO(listLot, request.Quantity, time.Now()) {
+		if _, err = q.DecreaseStockLotBalance(ctx, sqlc.DecreaseStockLotBalanceParams{
+			Quantity:      lot.Quantity,
+			ProductGuid:   lot.ProductGuid,
+			WarehouseGuid: lot.WarehouseGuid,
+			LotNumber:     lot.LotNumber,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed decrease stock lot balance")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	return
+}
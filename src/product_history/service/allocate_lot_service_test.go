@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+)
+
+func TestAllocateLotFEFO(t *testing.T) {
+	expiry := func(day int) sql.NullTime {
+		return sql.NullTime{Time: time.Date(2026, time.January, day, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+
+	asOf := time.Date(2026, time.January, 1, 14, 30, 0, 0, time.UTC)
+
+	// Sorted first-expiry-first-out, LOT-X expired before asOf, LOT-B and LOT-C share an expiry
+	// date and LOT-D never expires
+	listLot := []sqlc.StockLotBalance{
+		{LotNumber: "LOT-X", ExpiryDate: sql.NullTime{Time: time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC), Valid: true}, Quantity: 6},
+		{LotNumber: "LOT-Y", ExpiryDate: expiry(1), Quantity: 1},
+		{LotNumber: "LOT-A", ExpiryDate: expiry(5), Quantity: 4},
+		{LotNumber: "LOT-B", ExpiryDate: expiry(10), Quantity: 3},
+		{LotNumber: "LOT-C", ExpiryDate: expiry(10), Quantity: 5},
+		{LotNumber: "LOT-D", Quantity: 2},
+	}
+
+	tests := []struct {
+		name         string
+		quantity     int64
+		keepExpired  bool
+		wantLots     []string
+		wantQuantity []int64
+	}{
+		{
+			name:     "nothing to allocate",
+			quantity: 0,
+		},
+		{
+			name:         "skips lots that expired before today but takes one expiring today",
+			quantity:     3,
+			wantLots:     []string{"LOT-Y", "LOT-A"},
+			wantQuantity: []int64{1, 2},
+		},
+		{
+			name:         "empties the earliest lot before the next",
+			quantity:     7,
+			wantLots:     []string{"LOT-Y", "LOT-A", "LOT-B"},
+			wantQuantity: []int64{1, 4, 2},
+		},
+		{
+			name:         "keeps the given order between lots expiring together",
+			quantity:     10,
+			wantLots:     []string{"LOT-Y", "LOT-A", "LOT-B", "LOT-C"},
+			wantQuantity: []int64{1, 4, 3, 2},
+		},
+		{
+			name:         "takes lots without expiry last",
+			quantity:     14,
+			wantLots:     []string{"LOT-Y", "LOT-A", "LOT-B", "LOT-C", "LOT-D"},
+			wantQuantity: []int64{1, 4, 3, 5, 1},
+		},
+		{
+			name:         "leaves what the lots cannot cover unallocated",
+			quantity:     30,
+			wantLots:     []string{"LOT-Y", "LOT-A", "LOT-B", "LOT-C", "LOT-D"},
+			wantQuantity: []int64{1, 4, 3, 5, 2},
+		},
+		{
+			name:         "takes expired lots first without an as-of date",
+			quantity:     8,
+			keepExpired:  true,
+			wantLots:     []string{"LOT-X", "LOT-Y", "LOT-A"},
+			wantQuantity: []int64{6, 1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lotAsOf := asOf
+			if tt.keepExpired {
+				lotAsOf = time.Time{}
+			}
+
+			allocation := service.AllocateLotFEFO(listLot, tt.quantity, lotAsOf)
+
+			var (
+				gotLots     []string
+				gotQuantity []int64
+			)
+
+			for i := range allocation {
+				gotLots = append(gotLots, allocation[i].LotNumber)
+				gotQuantity = append(gotQuantity, allocation[i].Quantity)
+			}
+
+			if !reflect.DeepEqual(gotLots, tt.wantLots) || !reflect.DeepEqual(gotQuantity, tt.wantQuantity) {
+				t.Errorf("AllocateLotFEFO() = %v %v, want %v %v", gotLots, gotQuantity, tt.wantLots, tt.wantQuantity)
+			}
+
+			if listLot[0].Quantity != 6 {
+				t.Errorf("AllocateLotFEFO() changed the lot balances it was given")
+			}
+		})
+	}
+}
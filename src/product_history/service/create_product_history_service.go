@@ -0,0 +1,404 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	customerService "github.com/wit-id/blueprint-backend-go/src/customer/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockStatusService "github.com/wit-id/blueprint-backend-go/src/stock_status/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *ProductHistoryService) CreateProductHistoryMasuk(ctx context.Context, request sqlc.InsertProductsHistoryParams, serials []string) (productHistory sqlc.ProductsHistory, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	productHistory, err = RecordProductHistoryMasuk(ctx, q, request, serials)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *ProductHistoryService) CreateProductHistoryKeluar(ctx context.Context, request sqlc.InsertKeluarProductsHistoryParams, serials []string) (productHistory sqlc.ProductsHistory, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	productHistory, err = RecordProductHistoryKeluar(ctx, q, request, serials)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// RecordProductHistoryMasuk books an inbound movement into stock. It runs on the caller's
+// transaction so other modules can post movements atomically with their own documents.
+func RecordProductHistoryMasuk(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductsHistoryParams, serials []string) (productHistory sqlc.ProductsHistory, err error) {
+	// Goods come in as available unless the movement names another stock status
+	if request.StockStatus == "" {
+		request.StockStatus = constants.StockStatusAvailable
+	}
+
+	product, err := validateProductWarehouse(ctx, q, request.ProductGuid, request.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	request.Quantity, request.UnitQuantity, err = toBaseQuantity(ctx, q, product, request.Unit, request.Quantity)
+	if err != nil {
+		return
+	}
+
+	if err = validateSerials(product, serials, request.Quantity); err != nil {
+		return
+	}
+
+	if err = validateBin(ctx, q, request.BinGuid, request.WarehouseGuid); err != nil {
+		return
+	}
+
+	totalCost, err := inboundCost(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	request.TotalCost = sql.NullInt64{Int64: totalCost, Valid: true}
+
+	productHistory, err = q.InsertProductsHistory(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert product history masuk")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = recordInboundValuation(ctx, q, productHistory); err != nil {
+		return
+	}
+
+	if _, err = q.IncreaseStockBalance(ctx, sqlc.IncreaseStockBalanceParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+		Quantity:      request.Quantity,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed increase stock balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = stockStatusService.HoldStock(ctx, q, request.ProductGuid, request.WarehouseGuid, request.StockStatus, request.Quantity); err != nil {
+		return
+	}
+
+	if request.BinGuid.Valid {
+		if _, err = q.IncreaseStockLocationBalance(ctx, sqlc.IncreaseStockLocationBalanceParams{
+			ProductGuid:   request.ProductGuid,
+			WarehouseGuid: request.WarehouseGuid,
+			BinGuid:       request.BinGuid.String,
+			Quantity:      request.Quantity,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed increase stock location balance")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	if request.LotNumber.Valid {
+		if err = increaseStockLot(ctx, q, request); err != nil {
+			return
+		}
+	}
+
+	if err = receiveSerials(ctx, q, productHistory, serials); err != nil {
+		return
+	}
+
+	return
+}
+
+// RecordProductHistoryKeluar books an outbound movement out of stock on the caller's
+// transaction, refusing movements the stock on hand cannot cover.
+func RecordProductHistoryKeluar(ctx context.Context, q *sqlc.Queries, request sqlc.InsertKeluarProductsHistoryParams, serials []string) (productHistory sqlc.ProductsHistory, err error) {
+	// Goods leave from available stock unless the movement names a held status
+	if request.StockStatus == "" {
+		request.StockStatus = constants.StockStatusAvailable
+	}
+
+	product, err := validateProductWarehouse(ctx, q, request.ProductGuid, request.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	// The receiving customer and shipping address are stored on the movement when one is named
+	_, address, err := customerService.ResolveCustomerAddress(ctx, q, request.CustomerGuid, request.CustomerAddressGuid)
+	if err != nil {
+		return
+	}
+
+	request.CustomerAddressGuid = sql.NullString{
+		String: address.Guid,
+		Valid:  address.Guid != "",
+	}
+
+	request.Quantity, request.UnitQuantity, err = toBaseQuantity(ctx, q, product, request.Unit, request.Quantity)
+	if err != nil {
+		return
+	}
+
+	if err = validateSerials(product, serials, request.Quantity); err != nil {
+		return
+	}
+
+	if err = releaseSerials(ctx, q, request, serials); err != nil {
+		return
+	}
+
+	if err = validateBin(ctx, q, request.BinGuid, request.WarehouseGuid); err != nil {
+		return
+	}
+
+	if request.BinGuid.Valid {
+		if _, err = q.DecreaseStockLocationBalance(ctx, sqlc.DecreaseStockLocationBalanceParams{
+			Quantity:    request.Quantity,
+			ProductGuid: request.ProductGuid,
+			BinGuid:     request.BinGuid.String,
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				err = errors.WithStack(httpservice.ErrInsufficientStock)
+
+				return
+			}
+
+			log.FromCtx(ctx).Error(err, "failed decrease stock location balance")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	balance, err := q.DecreaseStockBalance(ctx, sqlc.DecreaseStockBalanceParams{
+		Quantity:      request.Quantity,
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrInsufficientStock)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed decrease stock balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = validateAvailableStock(ctx, q, request, balance); err != nil {
+		return
+	}
+
+	if err = decreaseStockLot(ctx, q, request); err != nil {
+		return
+	}
+
+	cogs, err := outboundCost(ctx, q, product, request.WarehouseGuid, request.Quantity)
+	if err != nil {
+		return
+	}
+
+	request.UnitCost = sql.NullInt64{}
+	request.TotalCost = sql.NullInt64{Int64: cogs, Valid: true}
+
+	productHistory, err = q.InsertKeluarProductsHistory(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert product history keluar")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = linkSerials(ctx, q, productHistory.Guid, serials); err != nil {
+		return
+	}
+
+	return
+}
+
+func validateProductWarehouse(ctx context.Context, q *sqlc.Queries, productGUID string, warehouseGUID string) (product sqlc.GetProductRow, err error) {
+	product, err = q.GetProduct(ctx, productGUID)
+	if err != nil || product.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	warehouse, err := q.GetWarehouse(ctx, warehouseGUID)
+	if err != nil || warehouse.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	return
+}
+
+// toBaseQuantity converts a quantity counted in the given unit to the product base unit.
+// The quantity as entered is kept next to the converted one for the movement record.
+func toBaseQuantity(ctx context.Context, q *sqlc.Queries, product sqlc.GetProductRow, unit sql.NullString, quantity int64) (baseQuantity int64, unitQuantity sql.NullInt64, err error) {
+	baseQuantity = quantity
+
+	if !unit.Valid {
+		return
+	}
+
+	unitQuantity = sql.NullInt64{Int64: quantity, Valid: true}
+
+	if unit.String == product.BaseUnit {
+		return
+	}
+
+	productUnit, err := q.GetProductUnit(ctx, sqlc.GetProductUnitParams{
+		ProductGuid: product.Guid,
+		Unit:        unit.String,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: unit %s is not configured for the product", unit.String)
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get product unit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	baseQuantity = quantity * productUnit.ConversionFactor
+
+	return
+}
+
+// validateBin makes sure an optional bin is an active bin location of the warehouse.
+func validateBin(ctx context.Context, q *sqlc.Queries, binGUID sql.NullString, warehouseGUID string) (err error) {
+	if !binGUID.Valid {
+		return
+	}
+
+	bin, err := q.GetWarehouseLocation(ctx, binGUID.String)
+	if err != nil || bin.DeletedAt.Valid || bin.WarehouseGuid != warehouseGUID {
+		log.FromCtx(ctx).Error(err, "failed get warehouse location")
+		err = errors.WithStack(httpservice.ErrWarehouseLocationNotFound)
+
+		return
+	}
+
+	if bin.LocationType != constants.WarehouseLocationTypeBin {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: stock can only be placed in a bin")
+		return
+	}
+
+	return
+}
+
+// validateAvailableStock checks what an outbound movement leaves behind. Held stock is
+// released from its status. Available stock must still cover the held quantities and,
+// outside of stock opname adjustments and write-offs, the stock promised to pending orders.
+// Reservations are consumed before their own movement is recorded, so the orders shipping
+// themselves are not blocked.
+func validateAvailableStock(ctx context.Context, q *sqlc.Queries, request sqlc.InsertKeluarProductsHistoryParams, balance sqlc.StockBalance) (err error) {
+	if request.StockStatus != constants.StockStatusAvailable {
+		return stockStatusService.ReleaseHeldStock(ctx, q, request.ProductGuid, request.WarehouseGuid, request.StockStatus, request.Quantity)
+	}
+
+	held, err := stockStatusService.GetHeldStock(ctx, q, balance.ProductGuid, balance.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	if balance.Quantity < held {
+		err = errors.Wrapf(httpservice.ErrInsufficientStock, "%d of the stock is held in quarantine, damaged or on hold", held)
+		return
+	}
+
+	if request.ReferenceType.String == constants.ProductHistoryReferenceStockOpname || request.ReasonCode.Valid {
+		return
+	}
+
+	reserved, err := q.GetSumActiveStockReservation(ctx, sqlc.GetSumActiveStockReservationParams{
+		ProductGuid:   balance.ProductGuid,
+		WarehouseGuid: balance.WarehouseGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get sum active stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if balance.Quantity-held < reserved {
+		err = errors.Wrapf(httpservice.ErrInsufficientStock, "%d of the stock is reserved for pending orders", reserved)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetCountProductHistory counts the rows an export of the list filter holds.
+func (s *ProductHistoryService) GetCountProductHistory(ctx context.Context, request sqlc.ListWithFilterProductHistoryParams) (totalData int64, err error) {
+	return s.getCountProductHistory(ctx, sqlc.New(s.mainDB), request)
+}
+
+// ExportProductHistory reads every product history matching the list filter, a page of request.LimitData rows
+// at a time, and hands each page to writePage.
+func (s *ProductHistoryService) ExportProductHistory(ctx context.Context, request sqlc.ListWithFilterProductHistoryParams, writePage func(listProductHistory []sqlc.ProductsHistory) error) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	for request.OffsetPage = 0; ; request.OffsetPage += request.LimitData {
+		listProductHistory, errList := q.ListWithFilterProductHistory(ctx, request)
+		if errList != nil {
+			log.FromCtx(ctx).Error(errList, "failed get list product history")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if len(listProductHistory) > 0 {
+			if err = writePage(listProductHistory); err != nil {
+				return
+			}
+		}
+
+		if len(listProductHistory) < int(request.LimitData) {
+			return
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *ProductHistoryService) ListProductHistory(ctx context.Context, request sqlc.ListWithFilterProductHistoryParams) (listProductHistory []sqlc.ProductsHistory, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountProductHistory(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listProductHistory, err = q.ListWithFilterProductHistory(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *ProductHistoryService) GetProductHistory(ctx context.Context, guid string) (productHistory sqlc.ProductsHistory, err error) {
+	q := sqlc.New(s.mainDB)
+
+	listProductHistory, err := q.FindWithGUIDProductsHistory(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get product history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if len(listProductHistory) == 0 {
+		err = errors.WithStack(httpservice.ErrProductHistoryNotFound)
+
+		return
+	}
+
+	productHistory = listProductHistory[0]
+
+	return
+}
+
+func (s *ProductHistoryService) getCountProductHistory(ctx context.Context, q *sqlc.Queries, request sqlc.ListWithFilterProductHistoryParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountProductHistoryParams{
+		SetPegawaiMasuk:  request.SetPegawaiMasuk,
+		PegawaiMasuk:     request.PegawaiMasuk,
+		SetPegawaiKeluar: request.SetPegawaiKeluar,
+		PegawaiKeluar:    request.PegawaiKeluar,
+		SetWarehouse:     request.SetWarehouse,
+		WarehouseGuid:    request.WarehouseGuid,
+		SetProduct:       request.SetProduct,
+		ProductGuid:      request.ProductGuid,
+		SetHistoryType:   request.SetHistoryType,
+		HistoryType:      request.HistoryType,
+		SetDate:          request.SetDate,
+		StartDate:        request.StartDate,
+		EndDate:          request.EndDate,
+		SetReference:     request.SetReference,
+		ReferenceType:    request.ReferenceType,
+		ReferenceGuid:    request.ReferenceGuid,
+	}
+
+	totalData, err = q.GetCountProductHistory(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list product history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// validateSerials checks that a serialized product moves with exactly one distinct serial
+// per unit, and that other products do not carry serials at all.
+func validateSerials(product sqlc.GetProductRow, serials []string, quantity int64) (err error) {
+	if !product.IsSerialized {
+		if len(serials) > 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: product is not serialized")
+		}
+
+		return
+	}
+
+	if int64(len(serials)) != quantity {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: expected %d serial numbers, got %d", quantity, len(serials))
+		return
+	}
+
+	seen := make(map[string]bool, len(serials))
+	for _, serial := range serials {
+		if serial == "" {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: serial number must not be empty")
+			return
+		}
+
+		if seen[serial] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: duplicate serial number %s", serial)
+			return
+		}
+
+		seen[serial] = true
+	}
+
+	return
+}
+
+// receiveSerials puts serials into stock at the movement's warehouse. New serials are
+// registered; known serials may only come back in once they have left stock.
+func receiveSerials(ctx context.Context, q *sqlc.Queries, productHistory sqlc.ProductsHistory, serials []string) (err error) {
+	warehouseGUID := sql.NullString{String: productHistory.WarehouseGuid, Valid: true}
+
+	for _, serialNumber := range serials {
+		serial, errSerial := q.GetProductSerialForUpdate(ctx, serialNumber)
+		switch {
+		case errors.Is(errSerial, sql.ErrNoRows):
+			if _, err = q.InsertProductSerial(ctx, sqlc.InsertProductSerialParams{
+				Guid:          utility.GenerateGoogleUUID(),
+				ProductGuid:   productHistory.ProductGuid,
+				SerialNumber:  serialNumber,
+				WarehouseGuid: warehouseGUID,
+				Status:        constants.ProductSerialStatusInStock,
+			}); err != nil {
+				log.FromCtx(ctx).Error(err, "failed insert product serial")
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		case errSerial != nil:
+			log.FromCtx(ctx).Error(errSerial, "failed get product serial")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		case serial.ProductGuid != productHistory.ProductGuid || serial.Status == constants.ProductSerialStatusInStock:
+			err = errors.Wrapf(httpservice.ErrDuplicateProductSerial, "serial number %s", serialNumber)
+			return
+		default:
+			if _, err = q.UpdateProductSerialStatus(ctx, sqlc.UpdateProductSerialStatusParams{
+				Status:        constants.ProductSerialStatusInStock,
+				WarehouseGuid: warehouseGUID,
+				SerialNumber:  serialNumber,
+			}); err != nil {
+				log.FromCtx(ctx).Error(err, "failed update product serial status")
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}
+
+	return linkSerials(ctx, q, productHistory.Guid, serials)
+}
+
+// releaseSerials takes serials out of stock, rejecting any that are unknown or not on hand
+// in the warehouse the movement leaves from.
+func releaseSerials(ctx context.Context, q *sqlc.Queries, request sqlc.InsertKeluarProductsHistoryParams, serials []string) (err error) {
+	for _, serialNumber := range serials {
+		serial, errSerial := q.GetProductSerialForUpdate(ctx, serialNumber)
+		if errSerial != nil {
+			if errors.Is(errSerial, sql.ErrNoRows) {
+				err = errors.Wrapf(httpservice.ErrProductSerialNotFound, "serial number %s", serialNumber)
+
+				return
+			}
+
+			log.FromCtx(ctx).Error(errSerial, "failed get product serial")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if serial.ProductGuid != request.ProductGuid || serial.Status != constants.ProductSerialStatusInStock || serial.WarehouseGuid.String != request.WarehouseGuid {
+			err = errors.Wrapf(httpservice.ErrProductSerialNotFound, "serial number %s is not in stock at this warehouse", serialNumber)
+			return
+		}
+
+		if _, err = q.UpdateProductSerialStatus(ctx, sqlc.UpdateProductSerialStatusParams{
+			Status:       constants.ProductSerialStatusOut,
+			SerialNumber: serialNumber,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed update product serial status")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	return
+}
+
+func linkSerials(ctx context.Context, q *sqlc.Queries, productHistoryGUID string, serials []string) (err error) {
+	for _, serialNumber := range serials {
+		if err = q.InsertProductSerialHistory(ctx, sqlc.InsertProductSerialHistoryParams{
+			SerialNumber:       serialNumber,
+			ProductHistoryGuid: productHistoryGUID,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert product serial history")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	return
+}
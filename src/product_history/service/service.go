@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type ProductHistoryService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewProductHistoryService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *ProductHistoryService {
+	return &ProductHistoryService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// Costs are whole amounts in the smallest currency unit. Every inbound movement opens a cost
+// layer and adds to the running valuation of its product and warehouse, so both the fifo and
+// the moving average figure are always at hand and a product can switch method at any time.
+
+// inboundCost returns the value of an inbound movement. An explicit total wins, then the unit
+// cost times the quantity as entered, and movements without a cost (adjustments, returns)
+// come in at the current average cost of the warehouse.
+func inboundCost(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductsHistoryParams) (totalCost int64, err error) {
+	if request.TotalCost.Valid {
+		totalCost = request.TotalCost.Int64
+		return
+	}
+
+	if request.UnitCost.Valid {
+		quantity := request.Quantity
+		if request.UnitQuantity.Valid {
+			quantity = request.UnitQuantity.Int64
+		}
+
+		totalCost = request.UnitCost.Int64 * quantity
+
+		return
+	}
+
+	valuation, err := getStockValuation(ctx, q, request.ProductGuid, request.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	if valuation.Quantity > 0 {
+		totalCost = valuation.TotalValue * request.Quantity / valuation.Quantity
+	}
+
+	return
+}
+
+// recordInboundValuation opens the cost layer of a stored inbound movement and adds it to the
+// running valuation.
+func recordInboundValuation(ctx context.Context, q *sqlc.Queries, productHistory sqlc.ProductsHistory) (err error) {
+	if _, err = q.InsertStockCostLayer(ctx, sqlc.InsertStockCostLayerParams{
+		Guid:                utility.GenerateGoogleUUID(),
+		ProductGuid:         productHistory.ProductGuid,
+		WarehouseGuid:       productHistory.WarehouseGuid,
+		ProductsHistoryGuid: productHistory.Guid,
+		Quantity:            productHistory.Quantity,
+		TotalCost:           productHistory.TotalCost.Int64,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert stock cost layer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.IncreaseStockValuation(ctx, sqlc.IncreaseStockValuationParams{
+		ProductGuid:   productHistory.ProductGuid,
+		WarehouseGuid: productHistory.WarehouseGuid,
+		Quantity:      productHistory.Quantity,
+		TotalValue:    productHistory.TotalCost.Int64,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed increase stock valuation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// outboundCost draws an outbound quantity from the cost layers oldest first and from the
+// running valuation, and returns the cost of goods sold under the product costing method.
+// Stock received before costing was recorded has no layer and leaves at zero cost.
+func outboundCost(ctx context.Context, q *sqlc.Queries, product sqlc.GetProductRow, warehouseGUID string, quantity int64) (cogs int64, err error) {
+	listLayer, err := q.ListOpenStockCostLayerForUpdate(ctx, sqlc.ListOpenStockCostLayerForUpdateParams{
+		ProductGuid:   product.Guid,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock cost layer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	var fifoCost int64
+
+	remaining := quantity
+
+	for i := 0; i < len(listLayer) && remaining > 0; i++ {
+		take, cost := listLayer[i].RemainingQuantity, listLayer[i].RemainingCost
+
+		// Split the layer pro rata, the last unit taken carries any rounding left over
+		if remaining < take {
+			take = remaining
+			cost = listLayer[i].RemainingCost * take / listLayer[i].RemainingQuantity
+		}
+
+		if err = q.ConsumeStockCostLayer(ctx, sqlc.ConsumeStockCostLayerParams{
+			Quantity: take,
+			Cost:     cost,
+			Guid:     listLayer[i].Guid,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed consume stock cost layer")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		fifoCost += cost
+		remaining -= take
+	}
+
+	valuation, err := getStockValuation(ctx, q, product.Guid, warehouseGUID)
+	if err != nil {
+		return
+	}
+
+	var averageCost int64
+
+	switch {
+	case valuation.Quantity <= 0:
+	case quantity >= valuation.Quantity:
+		averageCost = valuation.TotalValue
+	default:
+		averageCost = valuation.TotalValue * quantity / valuation.Quantity
+	}
+
+	cogs = fifoCost
+	if product.CostingMethod == constants.ProductCostingMethodAverage {
+		cogs = averageCost
+	}
+
+	if err = q.DecreaseStockValuation(ctx, sqlc.DecreaseStockValuationParams{
+		Quantity:      quantity,
+		TotalValue:    cogs,
+		ProductGuid:   product.Guid,
+		WarehouseGuid: warehouseGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed decrease stock valuation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// getStockValuation locks the running valuation, a product never costed reads as empty.
+func getStockValuation(ctx context.Context, q *sqlc.Queries, productGUID string, warehouseGUID string) (valuation sqlc.StockValuation, err error) {
+	valuation, err = q.GetStockValuationForUpdate(ctx, sqlc.GetStockValuationForUpdateParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock valuation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+)
+
+var (
+	costLayerColumns = []string{"id", "guid", "product_guid", "warehouse_guid", "products_history_guid", "quantity",
+		"remaining_quantity", "total_cost", "remaining_cost", "created_at"}
+	valuationColumns = []string{"id", "product_guid", "warehouse_guid", "quantity", "total_value", "created_at", "updated_at"}
+)
+
+// expectStockValuation answers the valuation lookup, a zero quantity reads as never costed.
+func expectStockValuation(mock sqlmock.Sqlmock, quantity int64, totalValue int64) {
+	rows := sqlmock.NewRows(valuationColumns)
+	if quantity > 0 {
+		rows.AddRow(1, "product-1", "warehouse-1", quantity, totalValue, time.Now(), nil)
+	}
+
+	mock.ExpectQuery("-- name: GetStockValuationForUpdate").WillReturnRows(rows)
+}
+
+func TestInboundCost(t *testing.T) {
+	tests := []struct {
+		name              string
+		request           sqlc.InsertProductsHistoryParams
+		lookupValuation   bool
+		valuationQuantity int64
+		valuationTotal    int64
+		wantTotalCost     int64
+	}{
+		{
+			name: "takes an explicit total cost",
+			request: sqlc.InsertProductsHistoryParams{
+				Quantity:  10,
+				UnitCost:  sql.NullInt64{Int64: 100, Valid: true},
+				TotalCost: sql.NullInt64{Int64: 950, Valid: true},
+			},
+			wantTotalCost: 950,
+		},
+		{
+			name: "prices the quantity as entered in another unit",
+			request: sqlc.InsertProductsHistoryParams{
+				Quantity:     24,
+				UnitQuantity: sql.NullInt64{Int64: 2, Valid: true},
+				UnitCost:     sql.NullInt64{Int64: 1200, Valid: true},
+			},
+			wantTotalCost: 2400,
+		},
+		{
+			name: "prices the base quantity without a unit",
+			request: sqlc.InsertProductsHistoryParams{
+				Quantity: 3,
+				UnitCost: sql.NullInt64{Int64: 150, Valid: true},
+			},
+			wantTotalCost: 450,
+		},
+		{
+			name:              "values an uncosted movement at the average cost rounded down",
+			request:           sqlc.InsertProductsHistoryParams{Quantity: 2},
+			lookupValuation:   true,
+			valuationQuantity: 3,
+			valuationTotal:    1000,
+			wantTotalCost:     666,
+		},
+		{
+			name:            "values an uncosted movement of a never costed product at zero",
+			request:         sqlc.InsertProductsHistoryParams{Quantity: 2},
+			lookupValuation: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			if tt.lookupValuation {
+				expectStockValuation(mock, tt.valuationQuantity, tt.valuationTotal)
+			}
+
+			gotTotalCost, err := inboundCost(context.Background(), sqlc.New(db), tt.request)
+			if err != nil {
+				t.Errorf("inboundCost() error = %v", err)
+				return
+			}
+
+			if gotTotalCost != tt.wantTotalCost {
+				t.Errorf("inboundCost() = %v, want %v", gotTotalCost, tt.wantTotalCost)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestOutboundCost(t *testing.T) {
+	// Two open layers, oldest first: 10 units for 1000 and 5 units for 600
+	layers := []sqlc.StockCostLayer{
+		{Guid: "layer-1", RemainingQuantity: 10, RemainingCost: 1000},
+		{Guid: "layer-2", RemainingQuantity: 5, RemainingCost: 600},
+	}
+
+	tests := []struct {
+		name              string
+		costingMethod     string
+		layers            []sqlc.StockCostLayer
+		valuationQuantity int64
+		valuationTotal    int64
+		quantity          int64
+		wantConsume       []sqlc.ConsumeStockCostLayerParams
+		wantCogs          int64
+	}{
+		{
+			name:              "splits the oldest layer pro rata",
+			costingMethod:     constants.ProductCostingMethodFIFO,
+			layers:            layers,
+			valuationQuantity: 15,
+			valuationTotal:    1600,
+			quantity:          4,
+			wantConsume:       []sqlc.ConsumeStockCostLayerParams{{Quantity: 4, Cost: 400, Guid: "layer-1"}},
+			wantCogs:          400,
+		},
+		{
+			name:              "exhausts the oldest layer before the next",
+			costingMethod:     constants.ProductCostingMethodFIFO,
+			layers:            layers,
+			valuationQuantity: 15,
+			valuationTotal:    1600,
+			quantity:          12,
+			wantConsume: []sqlc.ConsumeStockCostLayerParams{
+				{Quantity: 10, Cost: 1000, Guid: "layer-1"},
+				{Quantity: 2, Cost: 240, Guid: "layer-2"},
+			},
+			wantCogs: 1240,
+		},
+		{
+			name:              "takes stock beyond the layers at zero cost",
+			costingMethod:     constants.ProductCostingMethodFIFO,
+			layers:            layers,
+			valuationQuantity: 15,
+			valuationTotal:    1600,
+			quantity:          20,
+			wantConsume: []sqlc.ConsumeStockCostLayerParams{
+				{Quantity: 10, Cost: 1000, Guid: "layer-1"},
+				{Quantity: 5, Cost: 600, Guid: "layer-2"},
+			},
+			wantCogs: 1600,
+		},
+		{
+			name:              "rounds the average cost down",
+			costingMethod:     constants.ProductCostingMethodAverage,
+			layers:            []sqlc.StockCostLayer{{Guid: "layer-1", RemainingQuantity: 3, RemainingCost: 1000}},
+			valuationQuantity: 3,
+			valuationTotal:    1000,
+			quantity:          1,
+			wantConsume:       []sqlc.ConsumeStockCostLayerParams{{Quantity: 1, Cost: 333, Guid: "layer-1"}},
+			wantCogs:          333,
+		},
+		{
+			name:              "clears the remaining value with the last units at average cost",
+			costingMethod:     constants.ProductCostingMethodAverage,
+			layers:            []sqlc.StockCostLayer{{Guid: "layer-1", RemainingQuantity: 2, RemainingCost: 667}},
+			valuationQuantity: 2,
+			valuationTotal:    667,
+			quantity:          2,
+			wantConsume:       []sqlc.ConsumeStockCostLayerParams{{Quantity: 2, Cost: 667, Guid: "layer-1"}},
+			wantCogs:          667,
+		},
+		{
+			name:          "takes a never costed product at zero cost",
+			costingMethod: constants.ProductCostingMethodAverage,
+			quantity:      5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			rows := sqlmock.NewRows(costLayerColumns)
+			for _, layer := range tt.layers {
+				rows.AddRow(1, layer.Guid, "product-1", "warehouse-1", "history-1", layer.RemainingQuantity,
+					layer.RemainingQuantity, layer.RemainingCost, layer.RemainingCost, time.Now())
+			}
+
+			mock.ExpectQuery("-- name: ListOpenStockCostLayerForUpdate").WillReturnRows(rows)
+
+			for _, consume := range tt.wantConsume {
+				mock.ExpectExec("-- name: ConsumeStockCostLayer").
+					WithArgs(consume.Quantity, consume.Cost, consume.Guid).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			expectStockValuation(mock, tt.valuationQuantity, tt.valuationTotal)
+
+			mock.ExpectExec("-- name: DecreaseStockValuation").
+				WithArgs(tt.quantity, tt.wantCogs, "product-1", "warehouse-1").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			product := sqlc.GetProductRow{Guid: "product-1", CostingMethod: tt.costingMethod}
+
+			gotCogs, err := outboundCost(context.Background(), sqlc.New(db), product, "warehouse-1", tt.quantity)
+			if err != nil {
+				t.Errorf("outboundCost() error = %v", err)
+				return
+			}
+
+			if gotCogs != tt.wantCogs {
+				t.Errorf("outboundCost() = %v, want %v", gotCogs, tt.wantCogs)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/purchase_order/service"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRoutePurchaseOrder(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewPurchaseOrderService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	purchaseOrder := e.Group("/purchase-order")
+	purchaseOrder.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "purchase order ok")
+	})
+	purchaseOrder.Use(mddw.ValidateToken)
+	purchaseOrder.Use(mddw.ValidateUserHandheldLogin)
+
+	purchaseOrder.POST("/list", listPurchaseOrder(svc))
+	purchaseOrder.GET("/:guid", getPurchaseOrder(svc))
+	purchaseOrder.POST("/receive/:guid", receivePurchaseOrder(svc))
+
+	purchaseOrderBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "purchase-order")
+	purchaseOrderBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "purchase order ok")
+	})
+	purchaseOrderBO.Use(mddw.ValidateToken)
+	purchaseOrderBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	purchaseOrderBO.POST("/create", createPurchaseOrder(svc))
+	purchaseOrderBO.POST("/list", listPurchaseOrder(svc))
+	purchaseOrderBO.GET("/:guid", getPurchaseOrder(svc))
+}
+
+func createPurchaseOrder(svc *service.PurchaseOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.InsertPurchaseOrderPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		requestOrder := request.ToEntity(userBackoffice.Guid)
+
+		data, listItem, err := svc.CreatePurchaseOrder(ctx.Request().Context(), requestOrder, request.ToEntityItems(requestOrder.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadPurchaseOrder(data, listItem), nil)
+	}
+}
+
+func listPurchaseOrder(svc *service.PurchaseOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListPurchaseOrderPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListPurchaseOrder(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListPurchaseOrder(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getPurchaseOrder(svc *service.PurchaseOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listItem, err := svc.GetPurchaseOrder(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadPurchaseOrder(data, listItem), nil)
+	}
+}
+
+func receivePurchaseOrder(svc *service.PurchaseOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ReceivePurchaseOrderPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		data, listItem, err := svc.ReceivePurchaseOrder(ctx.Request().Context(), guid, request.ToEntity(guid), request.ToEntityMovement(), request.ToEntitySerial(), userHandheld.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadPurchaseOrder(data, listItem), nil)
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *PurchaseOrderService) CreatePurchaseOrder(ctx context.Context, request sqlc.InsertPurchaseOrderParams, requestItems []sqlc.InsertPurchaseOrderItemParams) (purchaseOrder sqlc.GetPurchaseOrderRow, listItem []sqlc.ListPurchaseOrderItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	warehouse, err := q.GetWarehouse(ctx, request.WarehouseGuid)
+	if err != nil || warehouse.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	if _, err = q.InsertPurchaseOrder(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert purchase order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range requestItems {
+		product, errGet := q.GetProduct(ctx, requestItems[i].ProductGuid)
+		if errGet != nil || product.DeletedAt.Valid {
+			log.FromCtx(ctx).Error(errGet, "failed get product")
+			err = errors.WithStack(httpservice.ErrProductNotFound)
+
+			return
+		}
+
+		if _, err = q.InsertPurchaseOrderItem(ctx, requestItems[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert purchase order item")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	purchaseOrder, listItem, err = getPurchaseOrderWithItems(ctx, q, request.Guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
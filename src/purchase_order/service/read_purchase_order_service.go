@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *PurchaseOrderService) ListPurchaseOrder(ctx context.Context, request sqlc.ListPurchaseOrderParams) (listPurchaseOrder []sqlc.ListPurchaseOrderRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountPurchaseOrder(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listPurchaseOrder, err = q.ListPurchaseOrder(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list purchase order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *PurchaseOrderService) GetPurchaseOrder(ctx context.Context, guid string) (purchaseOrder sqlc.GetPurchaseOrderRow, listItem []sqlc.ListPurchaseOrderItemRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	return getPurchaseOrderWithItems(ctx, q, guid)
+}
+
+func (s *PurchaseOrderService) getCountPurchaseOrder(ctx context.Context, q *sqlc.Queries, request sqlc.ListPurchaseOrderParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountPurchaseOrderParams{
+		SetPoNumber:     request.SetPoNumber,
+		PoNumber:        request.PoNumber,
+		SetSupplierName: request.SetSupplierName,
+		SupplierName:    request.SupplierName,
+		SetStatus:       request.SetStatus,
+		Status:          request.Status,
+		SetWarehouse:    request.SetWarehouse,
+		WarehouseGuid:   request.WarehouseGuid,
+	}
+
+	totalData, err = q.GetCountPurchaseOrder(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list purchase order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getPurchaseOrderWithItems(ctx context.Context, q *sqlc.Queries, guid string) (purchaseOrder sqlc.GetPurchaseOrderRow, listItem []sqlc.ListPurchaseOrderItemRow, err error) {
+	purchaseOrder, err = q.GetPurchaseOrder(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrPurchaseOrderNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get purchase order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listItem, err = q.ListPurchaseOrderItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list purchase order item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
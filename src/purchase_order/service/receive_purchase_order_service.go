@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ReceivePurchaseOrder books received quantities into the order's warehouse, tagging each
+// inbound movement with the purchase order, and advances the order status. Receipts are
+// valued at the unit cost given on receipt, else at the unit cost of the order item.
+func (s *PurchaseOrderService) ReceivePurchaseOrder(ctx context.Context, guid string, request []sqlc.ReceivePurchaseOrderItemParams, movements map[string]sqlc.InsertProductsHistoryParams, serials map[string][]string, userGUID string) (purchaseOrder sqlc.GetPurchaseOrderRow, listItem []sqlc.ListPurchaseOrderItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	// Lock the order so concurrent receipts see each other's quantities before the status is evaluated
+	order, err := q.GetPurchaseOrderForUpdate(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrPurchaseOrderNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get purchase order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if order.Status == constants.PurchaseOrderStatusClosed {
+		err = errors.WithStack(httpservice.ErrPurchaseOrderClosed)
+
+		return
+	}
+
+	for i := range request {
+		item, errReceive := q.ReceivePurchaseOrderItem(ctx, request[i])
+		if errReceive != nil {
+			if errors.Is(errReceive, sql.ErrNoRows) {
+				err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: item %s not found or quantity exceeds the remaining quantity", request[i].Guid)
+
+				return
+			}
+
+			log.FromCtx(ctx).Error(errReceive, "failed receive purchase order item")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		unitCost := movements[request[i].Guid].UnitCost
+		if !unitCost.Valid {
+			unitCost = item.UnitCost
+		}
+
+		if _, err = productHistoryService.RecordProductHistoryMasuk(ctx, q, sqlc.InsertProductsHistoryParams{
+			Guid:          utility.GenerateGoogleUUID(),
+			ProductGuid:   item.ProductGuid,
+			Quantity:      request[i].QuantityReceived,
+			WarehouseGuid: order.WarehouseGuid,
+			PegawaiMasuk:  userGUID,
+			CreatedBy:     userGUID,
+			ReferenceType: sql.NullString{String: constants.ProductHistoryReferencePurchaseOrder, Valid: true},
+			ReferenceGuid: sql.NullString{String: guid, Valid: true},
+			BinGuid:       movements[request[i].Guid].BinGuid,
+			LotNumber:     movements[request[i].Guid].LotNumber,
+			ExpiryDate:    movements[request[i].Guid].ExpiryDate,
+			UnitCost:      unitCost,
+		}, serials[request[i].Guid]); err != nil {
+			return
+		}
+	}
+
+	listItem, err = q.ListPurchaseOrderItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list purchase order item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.UpdatePurchaseOrderStatus(ctx, sqlc.UpdatePurchaseOrderStatusParams{
+		Status:    purchaseOrderStatus(listItem),
+		UpdatedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:      guid,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed update purchase order status")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	purchaseOrder, listItem, err = getPurchaseOrderWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func purchaseOrderStatus(listItem []sqlc.ListPurchaseOrderItemRow) string {
+	var received, complete int
+
+	for i := range listItem {
+		if listItem[i].QuantityReceived > 0 {
+			received++
+		}
+
+		if listItem[i].QuantityReceived >= listItem[i].QuantityOrdered {
+			complete++
+		}
+	}
+
+	switch {
+	case complete == len(listItem):
+		return constants.PurchaseOrderStatusClosed
+	case received > 0:
+		return constants.PurchaseOrderStatusPartiallyReceived
+	default:
+		return constants.PurchaseOrderStatusOpen
+	}
+}
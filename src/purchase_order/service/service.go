@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type PurchaseOrderService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewPurchaseOrderService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *PurchaseOrderService {
+	return &PurchaseOrderService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
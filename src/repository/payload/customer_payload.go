@@ -0,0 +1,399 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type RegisterCustomerPayload struct {
+	CustomerCode string `json:"customer_code" valid:"required"`
+	Name         string `json:"name" valid:"required"`
+	ContactName  string `json:"contact_name"`
+	Email        string `json:"email" valid:"email"`
+	PhoneNumber  string `json:"phone_number"`
+	TaxID        string `json:"tax_id"`
+}
+
+type UpdateCustomerPayload struct {
+	Name        string `json:"name" valid:"required"`
+	ContactName string `json:"contact_name"`
+	Email       string `json:"email" valid:"email"`
+	PhoneNumber string `json:"phone_number"`
+	TaxID       string `json:"tax_id"`
+}
+
+type ListCustomerPayload struct {
+	Filter ListCustomerFilterPayload `json:"filter"`
+	Limit  int32                     `json:"limit" valid:"required"`
+	Offset int32                     `json:"page" valid:"required"`
+	Order  string                    `json:"order" valid:"required"`
+	Sort   string                    `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListCustomerFilterPayload struct {
+	SetName         bool   `json:"set_name"`
+	Name            string `json:"name"`
+	SetCustomerCode bool   `json:"set_customer_code"`
+	CustomerCode    string `json:"customer_code"`
+	SetActive       bool   `json:"set_active"`
+	Active          string `json:"active"` // active, inactive
+}
+
+type CustomerAddressPayload struct {
+	Label         string `json:"label"` // e.g. head office, warehouse
+	RecipientName string `json:"recipient_name"`
+	PhoneNumber   string `json:"phone_number"`
+	Address       string `json:"address" valid:"required"`
+	City          string `json:"city"`
+	PostalCode    string `json:"postal_code"`
+	IsDefault     bool   `json:"is_default"`
+}
+
+type readCustomerPayload struct {
+	GUID         string                   `json:"id"`
+	CustomerCode string                   `json:"customer_code"`
+	Name         string                   `json:"name"`
+	ContactName  *string                  `json:"contact_name"`
+	Email        *string                  `json:"email"`
+	PhoneNumber  *string                  `json:"phone_number"`
+	TaxID        *string                  `json:"tax_id"`
+	Status       string                   `json:"status"`
+	CreatedAt    time.Time                `json:"created_at"`
+	CreatedBy    readUserCustomerPayload  `json:"created_by"`
+	UpdatedAt    *time.Time               `json:"updated_at"`
+	UpdatedBy    *readUserCustomerPayload `json:"updated_by"`
+}
+
+type readUserCustomerPayload struct {
+	GUID string `json:"id"`
+	Name string `json:"name"`
+}
+
+type readCustomerAddressPayload struct {
+	GUID          string     `json:"id"`
+	CustomerID    string     `json:"customer_id"`
+	Label         *string    `json:"label"`
+	RecipientName *string    `json:"recipient_name"`
+	PhoneNumber   *string    `json:"phone_number"`
+	Address       string     `json:"address"`
+	City          *string    `json:"city"`
+	PostalCode    *string    `json:"postal_code"`
+	IsDefault     bool       `json:"is_default"`
+	Status        string     `json:"status"`
+	CreatedAt     time.Time  `json:"created_at"`
+	CreatedBy     string     `json:"created_by"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+	UpdatedBy     *string    `json:"updated_by"`
+}
+
+func (payload *RegisterCustomerPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *UpdateCustomerPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *ListCustomerPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetActive && payload.Filter.Active != constants.StatusActive && payload.Filter.Active != constants.StatusInactive {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid active filter")
+		return
+	}
+
+	return
+}
+
+func (payload *CustomerAddressPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *RegisterCustomerPayload) ToEntity(userData sqlc.GetUserBackofficeRow) (data sqlc.InsertCustomerParams) {
+	data = sqlc.InsertCustomerParams{
+		Guid:         utility.GenerateGoogleUUID(),
+		CustomerCode: payload.CustomerCode,
+		Name:         payload.Name,
+		ContactName: sql.NullString{
+			String: payload.ContactName,
+			Valid:  payload.ContactName != "",
+		},
+		Email: sql.NullString{
+			String: payload.Email,
+			Valid:  payload.Email != "",
+		},
+		PhoneNumber: sql.NullString{
+			String: payload.PhoneNumber,
+			Valid:  payload.PhoneNumber != "",
+		},
+		TaxID: sql.NullString{
+			String: payload.TaxID,
+			Valid:  payload.TaxID != "",
+		},
+		CreatedBy: userData.Guid,
+	}
+
+	return
+}
+
+func (payload *UpdateCustomerPayload) ToEntity(userData sqlc.GetUserBackofficeRow, guid string) (data sqlc.UpdateCustomerParams) {
+	data = sqlc.UpdateCustomerParams{
+		Guid: guid,
+		Name: payload.Name,
+		ContactName: sql.NullString{
+			String: payload.ContactName,
+			Valid:  payload.ContactName != "",
+		},
+		Email: sql.NullString{
+			String: payload.Email,
+			Valid:  payload.Email != "",
+		},
+		PhoneNumber: sql.NullString{
+			String: payload.PhoneNumber,
+			Valid:  payload.PhoneNumber != "",
+		},
+		TaxID: sql.NullString{
+			String: payload.TaxID,
+			Valid:  payload.TaxID != "",
+		},
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+	}
+
+	return
+}
+
+func (payload *ListCustomerPayload) ToEntity() (data sqlc.ListCustomerParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListCustomerParams{
+		SetName:         payload.Filter.SetName,
+		Name:            "%" + payload.Filter.Name + "%",
+		SetCustomerCode: payload.Filter.SetCustomerCode,
+		CustomerCode:    "%" + payload.Filter.CustomerCode + "%",
+		SetActive:       payload.Filter.SetActive,
+		Active:          payload.Filter.Active,
+		LimitData:       payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadCustomer(customerData sqlc.GetCustomerRow) (payload readCustomerPayload) {
+	payload = readCustomerPayload{
+		GUID:         customerData.Guid,
+		CustomerCode: customerData.CustomerCode,
+		Name:         customerData.Name,
+		CreatedAt:    customerData.CreatedAt,
+		CreatedBy: readUserCustomerPayload{
+			GUID: customerData.CreatedBy,
+			Name: customerData.UserName.String,
+		},
+	}
+
+	if customerData.ContactName.Valid {
+		payload.ContactName = &customerData.ContactName.String
+	}
+
+	if customerData.Email.Valid {
+		payload.Email = &customerData.Email.String
+	}
+
+	if customerData.PhoneNumber.Valid {
+		payload.PhoneNumber = &customerData.PhoneNumber.String
+	}
+
+	if customerData.TaxID.Valid {
+		payload.TaxID = &customerData.TaxID.String
+	}
+
+	if customerData.UpdatedAt.Valid {
+		payload.UpdatedAt = &customerData.UpdatedAt.Time
+	}
+
+	if customerData.UpdatedBy.Valid {
+		payload.UpdatedBy = &readUserCustomerPayload{
+			GUID: customerData.UpdatedBy.String,
+			Name: customerData.UserNameUpdate.String,
+		}
+	}
+
+	if customerData.DeletedAt.Valid {
+		payload.Status = constants.StatusInactive
+	} else {
+		payload.Status = constants.StatusActive
+	}
+
+	return
+}
+
+func ToPayloadListCustomer(listCustomer []sqlc.ListCustomerRow) (payload []*readCustomerPayload) {
+	payload = make([]*readCustomerPayload, len(listCustomer))
+
+	for i := range listCustomer {
+		payload[i] = new(readCustomerPayload)
+		data := ToPayloadCustomer(sqlc.GetCustomerRow(listCustomer[i]))
+		payload[i] = &data
+	}
+
+	return
+}
+
+func (payload *CustomerAddressPayload) ToEntity(userData sqlc.GetUserBackofficeRow, customerGUID string) (data sqlc.InsertCustomerAddressParams) {
+	data = sqlc.InsertCustomerAddressParams{
+		Guid:         utility.GenerateGoogleUUID(),
+		CustomerGuid: customerGUID,
+		Label: sql.NullString{
+			String: payload.Label,
+			Valid:  payload.Label != "",
+		},
+		RecipientName: sql.NullString{
+			String: payload.RecipientName,
+			Valid:  payload.RecipientName != "",
+		},
+		PhoneNumber: sql.NullString{
+			String: payload.PhoneNumber,
+			Valid:  payload.PhoneNumber != "",
+		},
+		Address: payload.Address,
+		City: sql.NullString{
+			String: payload.City,
+			Valid:  payload.City != "",
+		},
+		PostalCode: sql.NullString{
+			String: payload.PostalCode,
+			Valid:  payload.PostalCode != "",
+		},
+		IsDefault: payload.IsDefault,
+		CreatedBy: userData.Guid,
+	}
+
+	return
+}
+
+func (payload *CustomerAddressPayload) ToEntityUpdate(userData sqlc.GetUserBackofficeRow, customerGUID string, guid string) (data sqlc.UpdateCustomerAddressParams) {
+	insert := payload.ToEntity(userData, customerGUID)
+
+	data = sqlc.UpdateCustomerAddressParams{
+		Label:         insert.Label,
+		RecipientName: insert.RecipientName,
+		PhoneNumber:   insert.PhoneNumber,
+		Address:       insert.Address,
+		City:          insert.City,
+		PostalCode:    insert.PostalCode,
+		IsDefault:     insert.IsDefault,
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid:         guid,
+		CustomerGuid: customerGUID,
+	}
+
+	return
+}
+
+func ToPayloadCustomerAddress(addressData sqlc.CustomerAddress) (payload readCustomerAddressPayload) {
+	payload = readCustomerAddressPayload{
+		GUID:       addressData.Guid,
+		CustomerID: addressData.CustomerGuid,
+		Address:    addressData.Address,
+		IsDefault:  addressData.IsDefault,
+		CreatedAt:  addressData.CreatedAt,
+		CreatedBy:  addressData.CreatedBy,
+	}
+
+	if addressData.Label.Valid {
+		payload.Label = &addressData.Label.String
+	}
+
+	if addressData.RecipientName.Valid {
+		payload.RecipientName = &addressData.RecipientName.String
+	}
+
+	if addressData.PhoneNumber.Valid {
+		payload.PhoneNumber = &addressData.PhoneNumber.String
+	}
+
+	if addressData.City.Valid {
+		payload.City = &addressData.City.String
+	}
+
+	if addressData.PostalCode.Valid {
+		payload.PostalCode = &addressData.PostalCode.String
+	}
+
+	if addressData.UpdatedAt.Valid {
+		payload.UpdatedAt = &addressData.UpdatedAt.Time
+		payload.UpdatedBy = &addressData.UpdatedBy.String
+	}
+
+	if addressData.DeletedAt.Valid {
+		payload.Status = constants.StatusInactive
+	} else {
+		payload.Status = constants.StatusActive
+	}
+
+	return
+}
+
+func ToPayloadListCustomerAddress(listAddress []sqlc.CustomerAddress) (payload []*readCustomerAddressPayload) {
+	payload = make([]*readCustomerAddressPayload, len(listAddress))
+
+	for i := range listAddress {
+		data := ToPayloadCustomerAddress(listAddress[i])
+		payload[i] = &data
+	}
+
+	return
+}
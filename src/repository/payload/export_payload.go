@@ -0,0 +1,311 @@
+package payload
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An export takes the same payload as the list it exports plus the file format. Limit and page
+// are ignored, every matching row is exported.
+
+type ExportProductPayload struct {
+	ListProductPayload
+	Format string `json:"format"` // csv, xlsx, pdf
+}
+
+type ExportWarehousePayload struct {
+	ListWarehousePayload
+	Format string `json:"format"` // csv, xlsx, pdf
+}
+
+type ExportUserBackofficePayload struct {
+	ListUserBackofficePayload
+	Format string `json:"format"` // csv, xlsx, pdf
+}
+
+type ExportUserHandheldPayload struct {
+	ListUserHandheldPayload
+	Format string `json:"format"` // csv, xlsx, pdf
+}
+
+type ExportProductHistoryPayload struct {
+	ListProductHistoryPayload
+	Format string `json:"format"` // csv, xlsx, pdf
+}
+
+type readExportFilePayload struct {
+	GUID         string                    `json:"id"`
+	ExportType   string                    `json:"export_type"`
+	Format       string                    `json:"format"`
+	FileName     string                    `json:"file_name"`
+	Status       string                    `json:"status"`
+	TotalRows    int64                     `json:"total_rows"`
+	ErrorMessage *string                   `json:"error_message"`
+	DownloadURL  *string                   `json:"download_url"` // set once the file is completed
+	CreatedAt    time.Time                 `json:"created_at"`
+	CreatedBy    readUserBackOfficePayload `json:"created_by"`
+	CompletedAt  *time.Time                `json:"completed_at"`
+}
+
+var (
+	ExportHeaderProduct        = []string{"sku", "name", "base_unit", "costing_method", "is_serialized", "categories", "description", "status", "created_at", "created_by"}
+	ExportHeaderWarehouse      = []string{"warehouse_code", "name", "address", "phone_number", "status", "created_at", "created_by"}
+	ExportHeaderUserBackoffice = []string{"name", "email", "phone", "role", "is_active", "last_login", "created_at"}
+	ExportHeaderUserHandheld   = []string{"name", "email", "phone", "gender", "address", "is_active", "last_login", "created_at"}
+	ExportHeaderProductHistory = []string{"id", "history_type", "product_id", "warehouse_id", "quantity", "unit", "unit_quantity", "stock_status", "lot_number", "expiry_date", "reference_type", "reference_id", "reason_code", "total_cost", "created_at"}
+)
+
+func (payload *ExportProductPayload) Validate() (err error) {
+	payload.Limit, payload.Offset = constants.ExportPageSize, 1
+
+	if err = payload.ListProductPayload.Validate(); err != nil {
+		return
+	}
+
+	return validateExportFormat(payload.Format)
+}
+
+func (payload *ExportWarehousePayload) Validate() (err error) {
+	payload.Limit, payload.Offset = constants.ExportPageSize, 1
+
+	if err = payload.ListWarehousePayload.Validate(); err != nil {
+		return
+	}
+
+	return validateExportFormat(payload.Format)
+}
+
+func (payload *ExportUserBackofficePayload) Validate() (err error) {
+	payload.Limit, payload.Offset = constants.ExportPageSize, 1
+
+	if err = payload.ListUserBackofficePayload.Validate(); err != nil {
+		return
+	}
+
+	return validateExportFormat(payload.Format)
+}
+
+func (payload *ExportUserHandheldPayload) Validate() (err error) {
+	payload.Limit, payload.Offset = constants.ExportPageSize, 1
+
+	if err = payload.ListUserHandheldPayload.Validate(); err != nil {
+		return
+	}
+
+	return validateExportFormat(payload.Format)
+}
+
+func (payload *ExportProductHistoryPayload) Validate() (err error) {
+	payload.Limit, payload.Offset = constants.ExportPageSize, 1
+
+	if err = payload.ListProductHistoryPayload.Validate(); err != nil {
+		return
+	}
+
+	return validateExportFormat(payload.Format)
+}
+
+func validateExportFormat(format string) (err error) {
+	switch format {
+	case constants.ExportFormatCSV, constants.ExportFormatXLSX, constants.ExportFormatPDF:
+	default:
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid export format %q", format)
+	}
+
+	return
+}
+
+// ToEntityExportFile names the file after the export type and the time it was asked for.
+func ToEntityExportFile(exportType string, format string, totalRows int64, userData sqlc.GetUserBackofficeRow) (data sqlc.InsertExportFileParams) {
+	data = sqlc.InsertExportFileParams{
+		Guid:       utility.GenerateGoogleUUID(),
+		ExportType: exportType,
+		Format:     format,
+		FileName:   fmt.Sprintf("%s-%s.%s", strings.ReplaceAll(exportType, "_", "-"), time.Now().UTC().Format("20060102-150405"), format),
+		Status:     constants.ExportStatusPending,
+		TotalRows:  totalRows,
+		CreatedBy:  userData.Guid,
+	}
+
+	return
+}
+
+// ToPayloadExportFile links a completed file to its download route under routePrefix, the
+// backoffice route prefix.
+func ToPayloadExportFile(exportFile sqlc.GetExportFileRow, routePrefix string) (payload readExportFilePayload) {
+	payload = readExportFilePayload{
+		GUID:       exportFile.Guid,
+		ExportType: exportFile.ExportType,
+		Format:     exportFile.Format,
+		FileName:   exportFile.FileName,
+		Status:     exportFile.Status,
+		TotalRows:  exportFile.TotalRows,
+		CreatedAt:  exportFile.CreatedAt,
+		CreatedBy: readUserBackOfficePayload{
+			GUID: exportFile.CreatedBy,
+			Name: exportFile.UserName.String,
+		},
+	}
+
+	if exportFile.ErrorMessage.Valid {
+		payload.ErrorMessage = &exportFile.ErrorMessage.String
+	}
+
+	if exportFile.Status == constants.ExportStatusCompleted {
+		downloadURL := routePrefix + "export/" + exportFile.Guid + "/download"
+		payload.DownloadURL = &downloadURL
+	}
+
+	if exportFile.CompletedAt.Valid {
+		payload.CompletedAt = &exportFile.CompletedAt.Time
+	}
+
+	return
+}
+
+func ToExportRowsProduct(listProduct []sqlc.ListProductRow, listCategory []sqlc.ListProductCategoryByProductsRow) (rows [][]string) {
+	productCategory := make(map[string][]string)
+	for i := range listCategory {
+		productCategory[listCategory[i].ProductGuid] = append(productCategory[listCategory[i].ProductGuid], listCategory[i].Name)
+	}
+
+	rows = make([][]string, len(listProduct))
+
+	for i := range listProduct {
+		rows[i] = []string{
+			listProduct[i].Sku.String,
+			listProduct[i].Name.String,
+			listProduct[i].BaseUnit,
+			listProduct[i].CostingMethod,
+			strconv.FormatBool(listProduct[i].IsSerialized),
+			strings.Join(productCategory[listProduct[i].Guid], constants.ImportListSep),
+			listProduct[i].Description,
+			exportStatus(listProduct[i].DeletedAt),
+			exportTime(sql.NullTime{Time: listProduct[i].CreatedAt, Valid: true}),
+			listProduct[i].UserName.String,
+		}
+	}
+
+	return
+}
+
+func ToExportRowsWarehouse(listWarehouse []sqlc.ListWarehouseRow) (rows [][]string) {
+	rows = make([][]string, len(listWarehouse))
+
+	for i := range listWarehouse {
+		rows[i] = []string{
+			listWarehouse[i].WarehouseCode,
+			listWarehouse[i].Name.String,
+			listWarehouse[i].Address,
+			listWarehouse[i].PhoneNumber,
+			exportStatus(listWarehouse[i].DeletedAt),
+			exportTime(sql.NullTime{Time: listWarehouse[i].CreatedAt, Valid: true}),
+			listWarehouse[i].UserName.String,
+		}
+	}
+
+	return
+}
+
+func ToExportRowsUserBackoffice(listUserBackoffice []sqlc.ListUserBackofficeRow) (rows [][]string) {
+	rows = make([][]string, len(listUserBackoffice))
+
+	for i := range listUserBackoffice {
+		rows[i] = []string{
+			listUserBackoffice[i].Name.String,
+			listUserBackoffice[i].Email,
+			listUserBackoffice[i].Phone,
+			listUserBackoffice[i].RoleName,
+			strconv.FormatBool(listUserBackoffice[i].IsActive.Bool),
+			exportTime(listUserBackoffice[i].LastLogin),
+			exportTime(sql.NullTime{Time: listUserBackoffice[i].CreatedAt, Valid: true}),
+		}
+	}
+
+	return
+}
+
+func ToExportRowsUserHandheld(listUserHandheld []sqlc.UserHandheld) (rows [][]string) {
+	rows = make([][]string, len(listUserHandheld))
+
+	for i := range listUserHandheld {
+		rows[i] = []string{
+			listUserHandheld[i].Name,
+			listUserHandheld[i].Email,
+			listUserHandheld[i].Phone.String,
+			listUserHandheld[i].Gender,
+			listUserHandheld[i].Address.String,
+			strconv.FormatBool(listUserHandheld[i].IsActive.Bool),
+			exportTime(listUserHandheld[i].LastLogin),
+			exportTime(sql.NullTime{Time: listUserHandheld[i].CreatedAt, Valid: true}),
+		}
+	}
+
+	return
+}
+
+func ToExportRowsProductHistory(listProductHistory []sqlc.ProductsHistory) (rows [][]string) {
+	rows = make([][]string, len(listProductHistory))
+
+	for i := range listProductHistory {
+		rows[i] = []string{
+			listProductHistory[i].Guid,
+			listProductHistory[i].HistoryType,
+			listProductHistory[i].ProductGuid,
+			listProductHistory[i].WarehouseGuid,
+			strconv.FormatInt(listProductHistory[i].Quantity, 10),
+			listProductHistory[i].Unit.String,
+			exportInt(listProductHistory[i].UnitQuantity),
+			listProductHistory[i].StockStatus,
+			listProductHistory[i].LotNumber.String,
+			exportDate(listProductHistory[i].ExpiryDate),
+			listProductHistory[i].ReferenceType.String,
+			listProductHistory[i].ReferenceGuid.String,
+			listProductHistory[i].ReasonCode.String,
+			exportInt(listProductHistory[i].TotalCost),
+			exportTime(sql.NullTime{Time: listProductHistory[i].CreatedAt, Valid: true}),
+		}
+	}
+
+	return
+}
+
+func exportStatus(deletedAt sql.NullTime) string {
+	if deletedAt.Valid {
+		return constants.StatusInactive
+	}
+
+	return constants.StatusActive
+}
+
+func exportTime(value sql.NullTime) string {
+	if !value.Valid {
+		return ""
+	}
+
+	return value.Time.UTC().Format(time.RFC3339)
+}
+
+func exportDate(value sql.NullTime) string {
+	if !value.Valid {
+		return ""
+	}
+
+	return value.Time.Format("2006-01-02")
+}
+
+func exportInt(value sql.NullInt64) string {
+	if !value.Valid {
+		return ""
+	}
+
+	return strconv.FormatInt(value.Int64, 10)
+}
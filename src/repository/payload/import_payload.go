@@ -0,0 +1,130 @@
+package payload
+
+import (
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ImportPayload is sent as multipart form next to the file field, which holds the csv or xlsx.
+// Columns are matched by header name, the same names as the json fields of the create payload.
+type ImportPayload struct {
+	// DryRun validates every row and reports the errors without storing anything
+	DryRun bool `form:"dry_run" query:"dry_run"`
+}
+
+type readImportPayload struct {
+	DryRun bool `json:"dry_run"`
+	Total  int  `json:"total"`
+	// Imported counts the rows stored, or that would be stored on a dry run
+	Imported int                         `json:"imported"`
+	Failed   int                         `json:"failed"`
+	Errors   []readImportRowErrorPayload `json:"errors"`
+}
+
+type readImportRowErrorPayload struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+func (payload *RegisterWarehousePayload) FromImportRow(row utility.ImportRow) (err error) {
+	payload.WarehouseCode = row.Get("warehouse_code")
+	payload.Name = row.Get("name")
+	payload.Address = row.Get("address")
+	payload.PhoneNumber = row.Get("phone_number")
+
+	return
+}
+
+func (payload *RegisterProductCategoryPayload) FromImportRow(row utility.ImportRow) (err error) {
+	payload.Name = row.Get("name")
+	payload.ParentID = row.Get("parent_id")
+
+	return
+}
+
+// FromImportRow reads a product row. Units are listed as unit:conversion_factor and barcodes as
+// barcode:barcode_type or barcode:barcode_type:unit, e.g. "box:12|carton:144".
+func (payload *RegisterProductPayload) FromImportRow(row utility.ImportRow) (err error) {
+	payload.Name = row.Get("name")
+	payload.Sku = row.Get("sku")
+	payload.ProductPictureUrl = row.Get("profile_picture_url")
+	payload.Description = row.Get("description")
+	payload.BaseUnit = row.Get("base_unit")
+	payload.CostingMethod = row.Get("costing_method")
+	payload.CategoryIDs = row.GetList("category_ids")
+
+	if isSerialized := row.Get("is_serialized"); isSerialized != "" {
+		if payload.IsSerialized, err = strconv.ParseBool(isSerialized); err != nil {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid is_serialized %q", isSerialized)
+			return
+		}
+	}
+
+	for _, item := range row.GetList("units") {
+		part := strings.Split(item, ":")
+		if len(part) != 2 {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid unit %q, expected unit:conversion_factor", item)
+			return
+		}
+
+		conversionFactor, errParse := strconv.ParseInt(strings.TrimSpace(part[1]), 10, 64)
+		if errParse != nil {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid conversion factor of unit %q", item)
+			return
+		}
+
+		payload.Units = append(payload.Units, RegisterProductUnitPayload{
+			Unit:             strings.TrimSpace(part[0]),
+			ConversionFactor: conversionFactor,
+		})
+	}
+
+	for _, item := range row.GetList("barcodes") {
+		part := strings.Split(item, ":")
+		if len(part) < 2 || len(part) > 3 {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid barcode %q, expected barcode:barcode_type[:unit]", item)
+			return
+		}
+
+		barcode := RegisterProductBarcodePayload{
+			Barcode:     strings.TrimSpace(part[0]),
+			BarcodeType: strings.TrimSpace(part[1]),
+		}
+
+		if len(part) == 3 {
+			barcode.Unit = strings.TrimSpace(part[2])
+		}
+
+		payload.Barcodes = append(payload.Barcodes, barcode)
+	}
+
+	return
+}
+
+// ToPayloadImport reports the outcome of an import, rowErrors holds the rejected rows by line.
+func ToPayloadImport(dryRun bool, total int, rowErrors map[int]error) (payload readImportPayload) {
+	payload = readImportPayload{
+		DryRun:   dryRun,
+		Total:    total,
+		Imported: total - len(rowErrors),
+		Failed:   len(rowErrors),
+		Errors:   make([]readImportRowErrorPayload, 0, len(rowErrors)),
+	}
+
+	for line, err := range rowErrors {
+		payload.Errors = append(payload.Errors, readImportRowErrorPayload{
+			Line:    line,
+			Message: err.Error(),
+		})
+	}
+
+	sort.Slice(payload.Errors, func(i, j int) bool {
+		return payload.Errors[i].Line < payload.Errors[j].Line
+	})
+
+	return
+}
@@ -0,0 +1,193 @@
+package payload
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type CreateJobPayload struct {
+	JobType string     `json:"job_type" valid:"required"` // reorder-point, stock-reservation-expiry, stock-snapshot
+	RunAt   *time.Time `json:"run_at"`                    // runs right away when empty
+}
+
+type ListJobPayload struct {
+	Filter ListJobFilterPayload `json:"filter"`
+	Limit  int32                `json:"limit" valid:"required"`
+	Offset int32                `json:"page" valid:"required"`
+}
+
+type ListJobFilterPayload struct {
+	SetStatus  bool   `json:"set_status"`
+	Status     string `json:"status"` // pending, running, completed, dead, cancelled
+	SetJobType bool   `json:"set_job_type"`
+	JobType    string `json:"job_type"`
+}
+
+type readJobPayload struct {
+	GUID        string          `json:"id"`
+	JobType     string          `json:"job_type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+	Attempts    int64           `json:"attempts"`
+	MaxAttempts int64           `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"` // next attempt of a pending job
+	LastError   *string         `json:"last_error"`
+	LockedAt    *time.Time      `json:"locked_at"`
+	LockedBy    *string         `json:"locked_by"` // worker running the job
+	CreatedAt   time.Time       `json:"created_at"`
+	CreatedBy   *string         `json:"created_by"` // empty for scheduled jobs
+	CompletedAt *time.Time      `json:"completed_at"`
+}
+
+type readJobSchedulePayload struct {
+	GUID           string     `json:"id"`
+	JobType        string     `json:"job_type"`
+	CronExpression string     `json:"cron_expression"`
+	NextRunAt      time.Time  `json:"next_run_at"`
+	LastRunAt      *time.Time `json:"last_run_at"`
+	IsActive       bool       `json:"is_active"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+}
+
+func (payload *CreateJobPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	// An export file job is only enqueued by the export it renders
+	switch payload.JobType {
+	case constants.JobTypeReorderPoint, constants.JobTypeStockReservationExpiry, constants.JobTypeStockSnapshot:
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid job_type")
+		return
+	}
+
+	return
+}
+
+func (payload *ListJobPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.JobStatusPending, constants.JobStatusRunning, constants.JobStatusCompleted, constants.JobStatusDead, constants.JobStatusCancelled:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *CreateJobPayload) ToEntity(userGUID string) (data sqlc.InsertJobParams) {
+	data = sqlc.InsertJobParams{
+		Guid:        utility.GenerateGoogleUUID(),
+		JobType:     payload.JobType,
+		MaxAttempts: constants.JobMaxAttempts,
+		RunAt:       time.Now().UTC(),
+		CreatedBy:   sql.NullString{String: userGUID, Valid: true},
+	}
+
+	if payload.RunAt != nil {
+		data.RunAt = payload.RunAt.UTC()
+	}
+
+	return
+}
+
+func (payload *ListJobPayload) ToEntity() (data sqlc.ListJobParams) {
+	data = sqlc.ListJobParams{
+		SetStatus:  payload.Filter.SetStatus,
+		Status:     payload.Filter.Status,
+		SetJobType: payload.Filter.SetJobType,
+		JobType:    payload.Filter.JobType,
+		OffsetPage: (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:  payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadJob(jobData sqlc.Job) (payload readJobPayload) {
+	payload = readJobPayload{
+		GUID:        jobData.Guid,
+		JobType:     jobData.JobType,
+		Payload:     jobData.Payload,
+		Status:      jobData.Status,
+		Attempts:    jobData.Attempts,
+		MaxAttempts: jobData.MaxAttempts,
+		RunAt:       jobData.RunAt,
+		CreatedAt:   jobData.CreatedAt,
+	}
+
+	if jobData.LastError.Valid {
+		payload.LastError = &jobData.LastError.String
+	}
+
+	if jobData.LockedAt.Valid {
+		payload.LockedAt = &jobData.LockedAt.Time
+		payload.LockedBy = &jobData.LockedBy.String
+	}
+
+	if jobData.CreatedBy.Valid {
+		payload.CreatedBy = &jobData.CreatedBy.String
+	}
+
+	if jobData.CompletedAt.Valid {
+		payload.CompletedAt = &jobData.CompletedAt.Time
+	}
+
+	return
+}
+
+func ToPayloadListJob(listJob []sqlc.Job) (payload []*readJobPayload) {
+	payload = make([]*readJobPayload, len(listJob))
+
+	for i := range listJob {
+		payload[i] = new(readJobPayload)
+		data := ToPayloadJob(listJob[i])
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadListJobSchedule(listSchedule []sqlc.JobSchedule) (payload []*readJobSchedulePayload) {
+	payload = make([]*readJobSchedulePayload, len(listSchedule))
+
+	for i := range listSchedule {
+		payload[i] = &readJobSchedulePayload{
+			GUID:           listSchedule[i].Guid,
+			JobType:        listSchedule[i].JobType,
+			CronExpression: listSchedule[i].CronExpression,
+			NextRunAt:      listSchedule[i].NextRunAt,
+			IsActive:       listSchedule[i].IsActive,
+			CreatedAt:      listSchedule[i].CreatedAt,
+		}
+
+		if listSchedule[i].LastRunAt.Valid {
+			payload[i].LastRunAt = &listSchedule[i].LastRunAt.Time
+		}
+
+		if listSchedule[i].UpdatedAt.Valid {
+			payload[i].UpdatedAt = &listSchedule[i].UpdatedAt.Time
+		}
+	}
+
+	return
+}
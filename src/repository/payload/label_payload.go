@@ -0,0 +1,75 @@
+package payload
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+)
+
+type ReadLabelPayload struct {
+	Symbology string `query:"symbology"` // code128, ean13, qr
+	Format    string `query:"format"`    // png, svg
+	Barcode   string `query:"barcode"`   // product labels only, defaults to the sku
+}
+
+type PrintProductLabelSheetPayload struct {
+	ProductIDs []string `json:"product_ids" valid:"required"`
+	Symbology  string   `json:"symbology"` // code128, ean13, qr
+}
+
+func (payload *ReadLabelPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Symbology, err = validateLabelSymbology(payload.Symbology); err != nil {
+		return
+	}
+
+	switch payload.Format {
+	case "":
+		payload.Format = constants.LabelFormatPNG
+	case constants.LabelFormatPNG, constants.LabelFormatSVG:
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid label format")
+		return
+	}
+
+	return
+}
+
+func (payload *PrintProductLabelSheetPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if len(payload.ProductIDs) > constants.LabelSheetMaxProducts {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: at most %d products per sheet", constants.LabelSheetMaxProducts)
+		return
+	}
+
+	if payload.Symbology, err = validateLabelSymbology(payload.Symbology); err != nil {
+		return
+	}
+
+	return
+}
+
+// validateLabelSymbology defaults an empty symbology to Code 128.
+func validateLabelSymbology(symbology string) (result string, err error) {
+	switch symbology {
+	case "":
+		result = constants.ProductBarcodeTypeCode128
+	case constants.ProductBarcodeTypeCode128, constants.ProductBarcodeTypeEAN13, constants.ProductBarcodeTypeQR:
+		result = symbology
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid label symbology")
+	}
+
+	return
+}
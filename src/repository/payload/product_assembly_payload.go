@@ -0,0 +1,257 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+// UpdateProductComponentPayload replaces the bill of materials of a kit, an empty list
+// turns the product back into a regular one.
+type UpdateProductComponentPayload struct {
+	Components []ProductComponentPayload `json:"components"`
+}
+
+type ProductComponentPayload struct {
+	ComponentProductID string `json:"component_product_id" valid:"required"`
+	Quantity           int64  `json:"quantity" valid:"required"` // in the component base unit, per kit
+}
+
+type InsertProductAssemblyPayload struct {
+	WarehouseID string `json:"warehouse_id" valid:"required"`
+	Quantity    int64  `json:"quantity" valid:"required"` // kits to assemble or take apart
+	Notes       string `json:"notes"`
+}
+
+type ListProductAssemblyPayload struct {
+	Filter ListProductAssemblyFilterPayload `json:"filter"`
+	Limit  int32                            `json:"limit" valid:"required"`
+	Offset int32                            `json:"page" valid:"required"`
+	Order  string                           `json:"order" valid:"required"`
+	Sort   string                           `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListProductAssemblyFilterPayload struct {
+	SetWarehouse bool   `json:"set_warehouse"`
+	WarehouseID  string `json:"warehouse_id"`
+	SetOperation bool   `json:"set_operation"`
+	Operation    string `json:"operation"` // assemble, disassemble
+}
+
+type readProductComponentPayload struct {
+	GUID               string    `json:"id"`
+	ComponentProductID string    `json:"component_product_id"`
+	ComponentName      string    `json:"component_name"`
+	ComponentSku       *string   `json:"component_sku"`
+	ComponentBaseUnit  string    `json:"component_base_unit"`
+	Quantity           int64     `json:"quantity"`
+	CreatedAt          time.Time `json:"created_at"`
+	CreatedBy          string    `json:"created_by"`
+}
+
+type readProductAssemblyPayload struct {
+	GUID           string                       `json:"id"`
+	AssemblyNumber string                       `json:"assembly_number"`
+	ProductID      string                       `json:"product_id"`
+	ProductName    string                       `json:"product_name"`
+	WarehouseID    string                       `json:"warehouse_id"`
+	WarehouseCode  string                       `json:"warehouse_code"`
+	WarehouseName  string                       `json:"warehouse_name"`
+	Operation      string                       `json:"operation"`
+	Quantity       int64                        `json:"quantity"`
+	Notes          *string                      `json:"notes"`
+	Movements      []*readProductHistoryPayload `json:"movements,omitempty"`
+	CreatedAt      time.Time                    `json:"created_at"`
+	CreatedBy      string                       `json:"created_by"`
+}
+
+func (payload *UpdateProductComponentPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	seen := make(map[string]bool, len(payload.Components))
+
+	for _, component := range payload.Components {
+		if component.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+
+		if seen[component.ComponentProductID] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: component %s is listed twice", component.ComponentProductID)
+			return
+		}
+
+		seen[component.ComponentProductID] = true
+	}
+
+	return
+}
+
+func (payload *InsertProductAssemblyPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Quantity <= 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+		return
+	}
+
+	return
+}
+
+func (payload *ListProductAssemblyPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetOperation && payload.Filter.Operation != constants.ProductAssemblyOperationAssemble && payload.Filter.Operation != constants.ProductAssemblyOperationDisassemble {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid operation")
+		return
+	}
+
+	return
+}
+
+func (payload *UpdateProductComponentPayload) ToEntity(productGUID string, userGUID string) (data []sqlc.InsertProductComponentParams) {
+	data = make([]sqlc.InsertProductComponentParams, len(payload.Components))
+
+	for i, component := range payload.Components {
+		data[i] = sqlc.InsertProductComponentParams{
+			Guid:                 utility.GenerateGoogleUUID(),
+			ProductGuid:          productGUID,
+			ComponentProductGuid: component.ComponentProductID,
+			Quantity:             component.Quantity,
+			CreatedBy:            userGUID,
+		}
+	}
+
+	return
+}
+
+func (payload *InsertProductAssemblyPayload) ToEntity(productGUID string, operation string, userGUID string) (data sqlc.InsertProductAssemblyParams) {
+	data = sqlc.InsertProductAssemblyParams{
+		Guid:           utility.GenerateGoogleUUID(),
+		AssemblyNumber: utility.GenerateDocumentNumber(constants.ProductAssemblyCodePrefix),
+		ProductGuid:    productGUID,
+		WarehouseGuid:  payload.WarehouseID,
+		Operation:      operation,
+		Quantity:       payload.Quantity,
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+	}
+
+	return
+}
+
+func (payload *ListProductAssemblyPayload) ToEntity(productGUID string) (data sqlc.ListProductAssemblyParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListProductAssemblyParams{
+		SetProduct:    true,
+		ProductGuid:   productGUID,
+		SetWarehouse:  payload.Filter.SetWarehouse,
+		WarehouseGuid: payload.Filter.WarehouseID,
+		SetOperation:  payload.Filter.SetOperation,
+		Operation:     payload.Filter.Operation,
+		LimitData:     payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadListProductComponent(listComponent []sqlc.ListProductComponentByProductRow) (payload []*readProductComponentPayload) {
+	payload = make([]*readProductComponentPayload, len(listComponent))
+
+	for i := range listComponent {
+		payload[i] = &readProductComponentPayload{
+			GUID:               listComponent[i].Guid,
+			ComponentProductID: listComponent[i].ComponentProductGuid,
+			ComponentName:      listComponent[i].ComponentName.String,
+			ComponentBaseUnit:  listComponent[i].ComponentBaseUnit.String,
+			Quantity:           listComponent[i].Quantity,
+			CreatedAt:          listComponent[i].CreatedAt,
+			CreatedBy:          listComponent[i].CreatedBy,
+		}
+
+		if listComponent[i].ComponentSku.Valid {
+			payload[i].ComponentSku = &listComponent[i].ComponentSku.String
+		}
+	}
+
+	return
+}
+
+func ToPayloadProductAssembly(assemblyData sqlc.GetProductAssemblyRow, listMovement []sqlc.ProductsHistory) (payload readProductAssemblyPayload) {
+	payload = readProductAssemblyPayload{
+		GUID:           assemblyData.Guid,
+		AssemblyNumber: assemblyData.AssemblyNumber,
+		ProductID:      assemblyData.ProductGuid,
+		ProductName:    assemblyData.ProductName.String,
+		WarehouseID:    assemblyData.WarehouseGuid,
+		WarehouseCode:  assemblyData.WarehouseCode.String,
+		WarehouseName:  assemblyData.WarehouseName.String,
+		Operation:      assemblyData.Operation,
+		Quantity:       assemblyData.Quantity,
+		CreatedAt:      assemblyData.CreatedAt,
+		CreatedBy:      assemblyData.CreatedBy,
+	}
+
+	if assemblyData.Notes.Valid {
+		payload.Notes = &assemblyData.Notes.String
+	}
+
+	if listMovement != nil {
+		payload.Movements = ToPayloadListProductHistory(listMovement)
+	}
+
+	return
+}
+
+func ToPayloadListProductAssembly(listAssembly []sqlc.ListProductAssemblyRow) (payload []*readProductAssemblyPayload) {
+	payload = make([]*readProductAssemblyPayload, len(listAssembly))
+
+	for i := range listAssembly {
+		payload[i] = new(readProductAssemblyPayload)
+		data := ToPayloadProductAssembly(sqlc.GetProductAssemblyRow(listAssembly[i]), nil)
+		payload[i] = &data
+	}
+
+	return
+}
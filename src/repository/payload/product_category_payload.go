@@ -12,13 +12,18 @@ import (
 )
 
 type RegisterProductCategoryPayload struct {
-	Name string `json:"name" valid:"required"`
+	Name     string `json:"name" valid:"required"`
+	ParentID string `json:"parent_id"`
 }
 
 type UpdateProductCategoryPayload struct {
 	Name string `json:"name" valid:"required"`
 }
 
+type MoveProductCategoryPayload struct {
+	ParentID string `json:"parent_id"` // empty moves the category to the root
+}
+
 type ListProductCategoryPayload struct {
 	Filter ListProductCategoryFilterPayload `json:"filter"`
 	Limit  int32                            `json:"limit" valid:"required"`
@@ -32,10 +37,15 @@ type ListProductCategoryFilterPayload struct {
 	Name      string `json:"name"`
 	SetActive bool   `json:"set_active"`
 	Active    string `json:"active"`
+	SetParent bool   `json:"set_parent"`
+	ParentID  string `json:"parent_id"`
+	// IncludeDescendants widens the parent filter to the whole subtree
+	IncludeDescendants bool `json:"include_descendants"`
 }
 
 type readRegisterProductCategoryPayload struct {
 	GUID      string                    `json:"id"`
+	ParentID  *string                   `json:"parent_id"`
 	Name      string                    `json:"name"`
 	Status    string                    `json:"status"`
 	CreatedAt time.Time                 `json:"created_at"`
@@ -44,6 +54,7 @@ type readRegisterProductCategoryPayload struct {
 
 type readUpdateProductCategoryPayload struct {
 	GUID      string                    `json:"id"`
+	ParentID  *string                   `json:"parent_id"`
 	Name      string                    `json:"name"`
 	Status    string                    `json:"status"`
 	UpdatedAt time.Time                 `json:"updated_at"`
@@ -52,6 +63,7 @@ type readUpdateProductCategoryPayload struct {
 
 type readProductCategoryPayload struct {
 	GUID      string                     `json:"id"`
+	ParentID  *string                    `json:"parent_id"`
 	Name      string                     `json:"name"`
 	Status    string                     `json:"status"`
 	CreatedAt time.Time                  `json:"created_at"`
@@ -62,6 +74,12 @@ type readProductCategoryPayload struct {
 	DeletedBy *readUserBackOfficePayload `json:"deleted_by"`
 }
 
+type readProductCategoryTreePayload struct {
+	GUID     string                            `json:"id"`
+	Name     string                            `json:"name"`
+	Children []*readProductCategoryTreePayload `json:"children"`
+}
+
 func (payload *RegisterProductCategoryPayload) Validate() (err error) {
 	// Validate Payload
 	if _, err = govalidator.ValidateStruct(payload); err != nil {
@@ -89,13 +107,22 @@ func (payload *ListProductCategoryPayload) Validate() (err error) {
 		return
 	}
 
+	if payload.Filter.SetParent && payload.Filter.ParentID == "" {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: parent_id is required when set_parent is true")
+		return
+	}
+
 	return
 }
 
 func (payload *RegisterProductCategoryPayload) ToEntity(userData sqlc.GetUserBackofficeRow) (data sqlc.InsertProductCategoryParams) {
 	data = sqlc.InsertProductCategoryParams{
-		Guid:      utility.GenerateGoogleUUID(),
-		Name:      payload.Name,
+		Guid: utility.GenerateGoogleUUID(),
+		Name: payload.Name,
+		ParentGuid: sql.NullString{
+			String: payload.ParentID,
+			Valid:  payload.ParentID != "",
+		},
 		CreatedBy: userData.Guid,
 	}
 
@@ -119,11 +146,14 @@ func (payload *ListProductCategoryPayload) ToEntity() (data sqlc.ListProductCate
 	orderParam := constants.DefaultOrderValue
 
 	data = sqlc.ListProductCategoryParams{
-		SetName:   payload.Filter.SetName,
-		Name:      "%" + payload.Filter.Name + "%",
-		SetActive: payload.Filter.SetActive,
-		Active:    payload.Filter.Active,
-		LimitData: payload.Limit,
+		SetName:            payload.Filter.SetName,
+		Name:               "%" + payload.Filter.Name + "%",
+		SetActive:          payload.Filter.SetActive,
+		Active:             payload.Filter.Active,
+		SetParent:          payload.Filter.SetParent,
+		ParentGuid:         payload.Filter.ParentID,
+		IncludeDescendants: payload.Filter.IncludeDescendants,
+		LimitData:          payload.Limit,
 	}
 
 	if payload.Limit == 0 {
@@ -163,6 +193,10 @@ func ToPayloadRegisterProductCategory(productCategoryData sqlc.ProductCategory,
 		payload.CreatedBy.Name = userData.Name.String
 	}
 
+	if productCategoryData.ParentGuid.Valid {
+		payload.ParentID = &productCategoryData.ParentGuid.String
+	}
+
 	if !productCategoryData.DeletedAt.Valid {
 		payload.Status = constants.StatusActive
 	} else {
@@ -186,6 +220,10 @@ func ToPayloadUpdateProductCategory(productCategoryData sqlc.ProductCategory, us
 		payload.UpdatedBy.Name = userData.Name.String
 	}
 
+	if productCategoryData.ParentGuid.Valid {
+		payload.ParentID = &productCategoryData.ParentGuid.String
+	}
+
 	if productCategoryData.DeletedAt.Valid {
 		payload.Status = constants.StatusInactive
 	} else {
@@ -210,6 +248,10 @@ func ToPayloadProductCategory(productCategoryData sqlc.GetProductCategoryRow) (p
 		payload.CreatedBy.Name = productCategoryData.UserName.String
 	}
 
+	if productCategoryData.ParentGuid.Valid {
+		payload.ParentID = &productCategoryData.ParentGuid.String
+	}
+
 	if productCategoryData.UpdatedAt.Valid {
 		payload.UpdatedAt = &productCategoryData.UpdatedAt.Time
 	}
@@ -252,3 +294,31 @@ func ToPayloadListProductCategory(listProductCategory []sqlc.ListProductCategory
 
 	return
 }
+
+// ToPayloadProductCategoryTree nests the flat category list under their parents. A category
+// whose parent is not in the list is shown at the root.
+func ToPayloadProductCategoryTree(listProductCategory []sqlc.ListProductCategoryTreeRow) (payload []*readProductCategoryTreePayload) {
+	payload = make([]*readProductCategoryTreePayload, 0)
+
+	node := make(map[string]*readProductCategoryTreePayload, len(listProductCategory))
+	for i := range listProductCategory {
+		node[listProductCategory[i].Guid] = &readProductCategoryTreePayload{
+			GUID:     listProductCategory[i].Guid,
+			Name:     listProductCategory[i].Name,
+			Children: make([]*readProductCategoryTreePayload, 0),
+		}
+	}
+
+	for i := range listProductCategory {
+		current := node[listProductCategory[i].Guid]
+
+		if parent, ok := node[listProductCategory[i].ParentGuid.String]; ok && listProductCategory[i].ParentGuid.Valid {
+			parent.Children = append(parent.Children, current)
+			continue
+		}
+
+		payload = append(payload, current)
+	}
+
+	return
+}
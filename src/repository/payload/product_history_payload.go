@@ -0,0 +1,387 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type InsertProductHistoryMasukPayload struct {
+	ProductID   string     `json:"product_id" valid:"required"`
+	WarehouseID string     `json:"warehouse_id" valid:"required"`
+	BinID       string     `json:"bin_id"`
+	LotNumber   string     `json:"lot_number"`
+	ExpiryDate  *time.Time `json:"expiry_date"`
+	Quantity    int64      `json:"quantity" valid:"required"`
+	Unit        string     `json:"unit"` // empty uses the product base unit
+	// UnitCost is the cost of one unit as entered, empty uses the current average cost
+	UnitCost *int64 `json:"unit_cost"`
+	// SerialNumbers lists one serial per unit for serialized products
+	SerialNumbers []string `json:"serial_numbers"`
+	// StockStatus receives the goods into quarantine, damaged or on_hold, empty means available
+	StockStatus string `json:"stock_status"`
+}
+
+type InsertProductHistoryKeluarPayload struct {
+	ProductID   string `json:"product_id" valid:"required"`
+	WarehouseID string `json:"warehouse_id" valid:"required"`
+	BinID       string `json:"bin_id"`
+	LotNumber   string `json:"lot_number"` // empty picks first-expiry-first-out
+	Quantity    int64  `json:"quantity" valid:"required"`
+	Unit        string `json:"unit"` // empty uses the product base unit
+	// SerialNumbers lists the scanned serials for serialized products
+	SerialNumbers []string `json:"serial_numbers"`
+	// CustomerID names who receives the goods, the address defaults to the customer default address
+	CustomerID        string `json:"customer_id"`
+	CustomerAddressID string `json:"customer_address_id"`
+	// StockStatus takes the goods out of a held status, empty means available
+	StockStatus string `json:"stock_status"`
+}
+
+type ListProductHistoryPayload struct {
+	Filter ListProductHistoryFilterPayload `json:"filter"`
+	Limit  int32                           `json:"limit" valid:"required"`
+	Offset int32                           `json:"page" valid:"required"`
+	Order  string                          `json:"order" valid:"required"`
+	Sort   string                          `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListProductHistoryFilterPayload struct {
+	SetPegawaiMasuk  bool      `json:"set_pegawai_masuk"`
+	PegawaiMasuk     string    `json:"pegawai_masuk"`
+	SetPegawaiKeluar bool      `json:"set_pegawai_keluar"`
+	PegawaiKeluar    string    `json:"pegawai_keluar"`
+	SetWarehouse     bool      `json:"set_warehouse"`
+	WarehouseID      string    `json:"warehouse_id"`
+	SetProduct       bool      `json:"set_product"`
+	ProductID        string    `json:"product_id"`
+	SetHistoryType   bool      `json:"set_history_type"`
+	HistoryType      string    `json:"history_type"` // masuk, keluar
+	SetDate          bool      `json:"set_date"`
+	StartDate        time.Time `json:"start_date"`
+	EndDate          time.Time `json:"end_date"`
+	SetReference     bool      `json:"set_reference"`
+	ReferenceType    string    `json:"reference_type"` // purchase_order, stock_transfer, stock_opname, sales_order, stock_return, product_assembly
+	ReferenceID      string    `json:"reference_id"`
+	SetCustomer      bool      `json:"set_customer"`
+	CustomerID       string    `json:"customer_id"`
+	SetStockStatus   bool      `json:"set_stock_status"`
+	StockStatus      string    `json:"stock_status"` // available, quarantine, damaged, on_hold
+}
+
+type readProductHistoryPayload struct {
+	GUID              string     `json:"id"`
+	ProductID         string     `json:"product_id"`
+	WarehouseID       string     `json:"warehouse_id"`
+	Quantity          int64      `json:"quantity"`
+	HistoryType       string     `json:"history_type"`
+	TglMasuk          *time.Time `json:"tgl_masuk"`
+	PegawaiMasuk      *string    `json:"pegawai_masuk"`
+	TglKeluar         *time.Time `json:"tgl_keluar"`
+	PegawaiKeluar     *string    `json:"pegawai_keluar"`
+	ReferenceType     *string    `json:"reference_type"`
+	ReferenceID       *string    `json:"reference_id"`
+	BinID             *string    `json:"bin_id"`
+	LotNumber         *string    `json:"lot_number"`
+	ExpiryDate        *time.Time `json:"expiry_date"`
+	Unit              *string    `json:"unit"`
+	UnitQuantity      *int64     `json:"unit_quantity"`
+	UnitCost          *int64     `json:"unit_cost"`
+	TotalCost         *int64     `json:"total_cost"` // inbound value, or cost of goods sold when keluar
+	CustomerID        *string    `json:"customer_id"`
+	CustomerAddressID *string    `json:"customer_address_id"`
+	ReasonCode        *string    `json:"reason_code"` // write-off reason
+	StockStatus       string     `json:"stock_status"`
+	CreatedAt         time.Time  `json:"created_at"`
+	CreatedBy         string     `json:"created_by"`
+}
+
+func (payload *InsertProductHistoryMasukPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Quantity <= 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+		return
+	}
+
+	if payload.ExpiryDate != nil && payload.LotNumber == "" {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: expiry date requires a lot number")
+		return
+	}
+
+	if payload.UnitCost != nil && *payload.UnitCost < 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: unit cost must not be negative")
+		return
+	}
+
+	if payload.StockStatus != "" && !isStockStatus(payload.StockStatus) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid stock status")
+		return
+	}
+
+	return
+}
+
+func (payload *InsertProductHistoryKeluarPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Quantity <= 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+		return
+	}
+
+	if payload.StockStatus != "" && !isStockStatus(payload.StockStatus) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid stock status")
+		return
+	}
+
+	return
+}
+
+func (payload *ListProductHistoryPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetHistoryType && payload.Filter.HistoryType != constants.ProductHistoryTypeMasuk && payload.Filter.HistoryType != constants.ProductHistoryTypeKeluar {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid history type")
+		return
+	}
+
+	if payload.Filter.SetDate && payload.Filter.EndDate.Before(payload.Filter.StartDate) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: end date must be after start date")
+		return
+	}
+
+	if payload.Filter.SetStockStatus && !isStockStatus(payload.Filter.StockStatus) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid stock status")
+		return
+	}
+
+	return
+}
+
+func (payload *InsertProductHistoryMasukPayload) ToEntity(userGUID string) (data sqlc.InsertProductsHistoryParams) {
+	data = sqlc.InsertProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   payload.ProductID,
+		Quantity:      payload.Quantity,
+		WarehouseGuid: payload.WarehouseID,
+		PegawaiMasuk:  userGUID,
+		CreatedBy:     userGUID,
+		BinGuid: sql.NullString{
+			String: payload.BinID,
+			Valid:  payload.BinID != "",
+		},
+		LotNumber: sql.NullString{
+			String: payload.LotNumber,
+			Valid:  payload.LotNumber != "",
+		},
+		Unit: sql.NullString{
+			String: payload.Unit,
+			Valid:  payload.Unit != "",
+		},
+		StockStatus: payload.StockStatus,
+	}
+
+	if payload.ExpiryDate != nil {
+		data.ExpiryDate = sql.NullTime{
+			Time:  *payload.ExpiryDate,
+			Valid: true,
+		}
+	}
+
+	if payload.UnitCost != nil {
+		data.UnitCost = sql.NullInt64{
+			Int64: *payload.UnitCost,
+			Valid: true,
+		}
+	}
+
+	return
+}
+
+func (payload *InsertProductHistoryKeluarPayload) ToEntity(userGUID string) (data sqlc.InsertKeluarProductsHistoryParams) {
+	data = sqlc.InsertKeluarProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   payload.ProductID,
+		Quantity:      payload.Quantity,
+		WarehouseGuid: payload.WarehouseID,
+		PegawaiKeluar: userGUID,
+		CreatedBy:     userGUID,
+		BinGuid: sql.NullString{
+			String: payload.BinID,
+			Valid:  payload.BinID != "",
+		},
+		LotNumber: sql.NullString{
+			String: payload.LotNumber,
+			Valid:  payload.LotNumber != "",
+		},
+		Unit: sql.NullString{
+			String: payload.Unit,
+			Valid:  payload.Unit != "",
+		},
+		CustomerGuid: sql.NullString{
+			String: payload.CustomerID,
+			Valid:  payload.CustomerID != "",
+		},
+		CustomerAddressGuid: sql.NullString{
+			String: payload.CustomerAddressID,
+			Valid:  payload.CustomerAddressID != "",
+		},
+		StockStatus: payload.StockStatus,
+	}
+
+	return
+}
+
+func (payload *ListProductHistoryPayload) ToEntity() (data sqlc.ListWithFilterProductHistoryParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListWithFilterProductHistoryParams{
+		SetPegawaiMasuk:  payload.Filter.SetPegawaiMasuk,
+		PegawaiMasuk:     "%" + payload.Filter.PegawaiMasuk + "%",
+		SetPegawaiKeluar: payload.Filter.SetPegawaiKeluar,
+		PegawaiKeluar:    "%" + payload.Filter.PegawaiKeluar + "%",
+		SetWarehouse:     payload.Filter.SetWarehouse,
+		WarehouseGuid:    payload.Filter.WarehouseID,
+		SetProduct:       payload.Filter.SetProduct,
+		ProductGuid:      payload.Filter.ProductID,
+		SetHistoryType:   payload.Filter.SetHistoryType,
+		HistoryType:      payload.Filter.HistoryType,
+		SetDate:          payload.Filter.SetDate,
+		StartDate:        payload.Filter.StartDate,
+		EndDate:          payload.Filter.EndDate,
+		SetReference:     payload.Filter.SetReference,
+		ReferenceType: sql.NullString{
+			String: payload.Filter.ReferenceType,
+			Valid:  true,
+		},
+		ReferenceGuid: sql.NullString{
+			String: payload.Filter.ReferenceID,
+			Valid:  true,
+		},
+		SetCustomer: payload.Filter.SetCustomer,
+		CustomerGuid: sql.NullString{
+			String: payload.Filter.CustomerID,
+			Valid:  true,
+		},
+		SetStockStatus: payload.Filter.SetStockStatus,
+		StockStatus:    payload.Filter.StockStatus,
+		LimitData:      payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadProductHistory(productHistoryData sqlc.ProductsHistory) (payload readProductHistoryPayload) {
+	payload = readProductHistoryPayload{
+		GUID:        productHistoryData.Guid,
+		ProductID:   productHistoryData.ProductGuid,
+		WarehouseID: productHistoryData.WarehouseGuid,
+		Quantity:    productHistoryData.Quantity,
+		HistoryType: productHistoryData.HistoryType,
+		StockStatus: productHistoryData.StockStatus,
+		CreatedAt:   productHistoryData.CreatedAt,
+		CreatedBy:   productHistoryData.CreatedBy,
+	}
+
+	if productHistoryData.HistoryType == constants.ProductHistoryTypeMasuk {
+		payload.TglMasuk = &productHistoryData.TglMasuk
+		payload.PegawaiMasuk = &productHistoryData.PegawaiMasuk
+	} else {
+		payload.TglKeluar = &productHistoryData.TglKeluar
+		payload.PegawaiKeluar = &productHistoryData.PegawaiKeluar
+	}
+
+	if productHistoryData.ReferenceType.Valid {
+		payload.ReferenceType = &productHistoryData.ReferenceType.String
+		payload.ReferenceID = &productHistoryData.ReferenceGuid.String
+	}
+
+	if productHistoryData.BinGuid.Valid {
+		payload.BinID = &productHistoryData.BinGuid.String
+	}
+
+	if productHistoryData.LotNumber.Valid {
+		payload.LotNumber = &productHistoryData.LotNumber.String
+	}
+
+	if productHistoryData.ExpiryDate.Valid {
+		payload.ExpiryDate = &productHistoryData.ExpiryDate.Time
+	}
+
+	if productHistoryData.Unit.Valid {
+		payload.Unit = &productHistoryData.Unit.String
+		payload.UnitQuantity = &productHistoryData.UnitQuantity.Int64
+	}
+
+	if productHistoryData.UnitCost.Valid {
+		payload.UnitCost = &productHistoryData.UnitCost.Int64
+	}
+
+	if productHistoryData.TotalCost.Valid {
+		payload.TotalCost = &productHistoryData.TotalCost.Int64
+	}
+
+	if productHistoryData.CustomerGuid.Valid {
+		payload.CustomerID = &productHistoryData.CustomerGuid.String
+	}
+
+	if productHistoryData.CustomerAddressGuid.Valid {
+		payload.CustomerAddressID = &productHistoryData.CustomerAddressGuid.String
+	}
+
+	if productHistoryData.ReasonCode.Valid {
+		payload.ReasonCode = &productHistoryData.ReasonCode.String
+	}
+
+	return
+}
+
+func ToPayloadListProductHistory(listProductHistory []sqlc.ProductsHistory) (payload []*readProductHistoryPayload) {
+	payload = make([]*readProductHistoryPayload, len(listProductHistory))
+
+	for i := range listProductHistory {
+		payload[i] = new(readProductHistoryPayload)
+		data := ToPayloadProductHistory(listProductHistory[i])
+		payload[i] = &data
+	}
+
+	return
+}
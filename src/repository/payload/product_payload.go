@@ -12,15 +12,41 @@ import (
 )
 
 type RegisterProductPayload struct {
-	Name              string `json:"name" valid:"required"`
-	ProductPictureUrl string `json:"profile_picture_url"`
-	Description       string `json:"description"`
+	Name              string                          `json:"name" valid:"required"`
+	Sku               string                          `json:"sku" valid:"required"`
+	ProductPictureUrl string                          `json:"profile_picture_url"`
+	Description       string                          `json:"description"`
+	IsSerialized      bool                            `json:"is_serialized"`
+	BaseUnit          string                          `json:"base_unit"`      // defaults to pcs
+	CostingMethod     string                          `json:"costing_method"` // fifo, average; defaults to fifo
+	CategoryIDs       []string                        `json:"category_ids"`
+	Barcodes          []RegisterProductBarcodePayload `json:"barcodes"`
+	Units             []RegisterProductUnitPayload    `json:"units"`
 }
 
 type UpdateProductPayload struct {
-	Name              string `json:"name" valid:"required"`
-	ProductPictureUrl string `json:"profile_picture_url"`
-	Description       string `json:"description"`
+	Name              string  `json:"name" valid:"required"`
+	Sku               string  `json:"sku" valid:"required"`
+	ProductPictureUrl string  `json:"profile_picture_url"`
+	Description       string  `json:"description"`
+	IsSerialized      bool    `json:"is_serialized"`
+	BaseUnit          string  `json:"base_unit"`      // omit to keep the current base unit
+	CostingMethod     *string `json:"costing_method"` // fifo, average; omit to keep the current method
+	// CategoryIDs, Barcodes and Units replace the current ones, omit them to keep what is there
+	CategoryIDs []string                        `json:"category_ids"`
+	Barcodes    []RegisterProductBarcodePayload `json:"barcodes"`
+	Units       []RegisterProductUnitPayload    `json:"units"`
+}
+
+type RegisterProductBarcodePayload struct {
+	Barcode     string `json:"barcode" valid:"required"`
+	BarcodeType string `json:"barcode_type" valid:"required"` // ean13, upc, code128, qr
+	Unit        string `json:"unit"`                          // empty means the base unit
+}
+
+type RegisterProductUnitPayload struct {
+	Unit             string `json:"unit" valid:"required"`
+	ConversionFactor int64  `json:"conversion_factor" valid:"required"` // quantity of base unit in one unit
 }
 
 type ListProductPayload struct {
@@ -32,18 +58,53 @@ type ListProductPayload struct {
 }
 
 type ListProductFilterPayload struct {
-	SetName bool   `json:"set_name"`
-	Name    string `json:"name"`
+	SetName     bool   `json:"set_name"`
+	Name        string `json:"name"`
+	SetCategory bool   `json:"set_category"`
+	CategoryID  string `json:"category_id"`
+	// IncludeSubCategory also matches products of every descendant category
+	IncludeSubCategory bool `json:"include_sub_category"`
 }
 
 type readRegisterProductPayload struct {
-	GUID              string                    `json:"id"`
-	Name              string                    `json:"name"`
-	ProductPictureUrl *string                   `json:"profile_picture_image_url"`
-	Description       string                    `json:"description"`
-	Status            string                    `json:"status"`
-	CreatedAt         time.Time                 `json:"created_at"`
-	CreatedBy         readUserBackOfficePayload `json:"created_by"`
+	GUID              string                           `json:"id"`
+	Name              string                           `json:"name"`
+	Sku               string                           `json:"sku"`
+	BaseUnit          string                           `json:"base_unit"`
+	CostingMethod     string                           `json:"costing_method"`
+	ProductPictureUrl *string                          `json:"profile_picture_image_url"`
+	Description       string                           `json:"description"`
+	IsSerialized      bool                             `json:"is_serialized"`
+	Status            string                           `json:"status"`
+	CreatedAt         time.Time                        `json:"created_at"`
+	CreatedBy         readUserBackOfficePayload        `json:"created_by"`
+	Categories        []readProductCategoryLinkPayload `json:"categories"`
+	Barcodes          []readProductBarcodePayload      `json:"barcodes"`
+	Units             []readProductUnitPayload         `json:"units"`
+}
+
+type readProductBarcodePayload struct {
+	Barcode     string  `json:"barcode"`
+	BarcodeType string  `json:"barcode_type"`
+	Unit        *string `json:"unit"`
+}
+
+type readProductUnitPayload struct {
+	Unit             string `json:"unit"`
+	ConversionFactor int64  `json:"conversion_factor"`
+}
+
+type readProductBarcodeLookupPayload struct {
+	Barcode          string             `json:"barcode"`
+	BarcodeType      string             `json:"barcode_type"`
+	Unit             string             `json:"unit"`
+	ConversionFactor int64              `json:"conversion_factor"`
+	Product          readProductPayload `json:"product"`
+}
+
+type readProductCategoryLinkPayload struct {
+	GUID string `json:"id"`
+	Name string `json:"name"`
 }
 
 type readUserBackOfficePayload struct {
@@ -52,25 +113,39 @@ type readUserBackOfficePayload struct {
 }
 
 type readUpdateProductPayload struct {
-	GUID              string                    `json:"id"`
-	Name              string                    `json:"name"`
-	ProductPictureUrl *string                   `json:"profile_picture_image_url"`
-	Description       string                    `json:"description"`
-	Status            string                    `json:"status"`
-	UpdatedAt         time.Time                 `json:"updated_at"`
-	UpdatedBy         readUserBackOfficePayload `json:"updated_by"`
+	GUID              string                           `json:"id"`
+	Name              string                           `json:"name"`
+	Sku               string                           `json:"sku"`
+	BaseUnit          string                           `json:"base_unit"`
+	CostingMethod     string                           `json:"costing_method"`
+	ProductPictureUrl *string                          `json:"profile_picture_image_url"`
+	Description       string                           `json:"description"`
+	IsSerialized      bool                             `json:"is_serialized"`
+	Status            string                           `json:"status"`
+	UpdatedAt         time.Time                        `json:"updated_at"`
+	UpdatedBy         readUserBackOfficePayload        `json:"updated_by"`
+	Categories        []readProductCategoryLinkPayload `json:"categories"`
+	Barcodes          []readProductBarcodePayload      `json:"barcodes"`
+	Units             []readProductUnitPayload         `json:"units"`
 }
 
 type readProductPayload struct {
-	GUID              string                     `json:"id"`
-	Name              string                     `json:"name"`
-	ProductPictureUrl *string                    `json:"profile_picture_image_url"`
-	Description       string                     `json:"description"`
-	Status            string                     `json:"status"`
-	CreatedAt         time.Time                  `json:"created_at"`
-	CreatedBy         readUserBackOfficePayload  `json:"created_by"`
-	UpdatedAt         *time.Time                 `json:"updated_at"`
-	UpdatedBy         *readUserBackOfficePayload `json:"updated_by"`
+	GUID              string                           `json:"id"`
+	Name              string                           `json:"name"`
+	Sku               string                           `json:"sku"`
+	BaseUnit          string                           `json:"base_unit"`
+	CostingMethod     string                           `json:"costing_method"`
+	ProductPictureUrl *string                          `json:"profile_picture_image_url"`
+	Description       string                           `json:"description"`
+	IsSerialized      bool                             `json:"is_serialized"`
+	Status            string                           `json:"status"`
+	CreatedAt         time.Time                        `json:"created_at"`
+	CreatedBy         readUserBackOfficePayload        `json:"created_by"`
+	UpdatedAt         *time.Time                       `json:"updated_at"`
+	UpdatedBy         *readUserBackOfficePayload       `json:"updated_by"`
+	Categories        []readProductCategoryLinkPayload `json:"categories"`
+	Barcodes          []readProductBarcodePayload      `json:"barcodes"`
+	Units             []readProductUnitPayload         `json:"units"`
 }
 
 func (payload *RegisterProductPayload) Validate() (err error) {
@@ -80,7 +155,15 @@ func (payload *RegisterProductPayload) Validate() (err error) {
 		return
 	}
 
-	return
+	if payload.BaseUnit == "" {
+		payload.BaseUnit = constants.ProductBaseUnitDefault
+	}
+
+	if payload.CostingMethod, err = validateProductCostingMethod(payload.CostingMethod); err != nil {
+		return
+	}
+
+	return validateProductUnitBarcode(payload.BaseUnit, payload.Units, payload.Barcodes)
 }
 
 func (payload *UpdateProductPayload) Validate() (err error) {
@@ -90,6 +173,83 @@ func (payload *UpdateProductPayload) Validate() (err error) {
 		return
 	}
 
+	if payload.CostingMethod != nil {
+		if *payload.CostingMethod, err = validateProductCostingMethod(*payload.CostingMethod); err != nil {
+			return
+		}
+	}
+
+	return validateProductUnitBarcode(payload.BaseUnit, payload.Units, payload.Barcodes)
+}
+
+// validateProductCostingMethod defaults an empty costing method to fifo. The method can only
+// change while the product has no stock, past cost of goods sold is not restated.
+func validateProductCostingMethod(costingMethod string) (method string, err error) {
+	switch costingMethod {
+	case "":
+		method = constants.ProductCostingMethodFIFO
+	case constants.ProductCostingMethodFIFO, constants.ProductCostingMethodAverage:
+		method = costingMethod
+	default:
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid costing method %q", costingMethod)
+	}
+
+	return
+}
+
+// validateProductUnitBarcode checks the conversion units and barcodes on their own. Whether a
+// barcode unit is configured is left to the service, since an update may keep the current units
+// or base unit, an empty baseUnit skips the base unit check for the same reason.
+func validateProductUnitBarcode(baseUnit string, units []RegisterProductUnitPayload, barcodes []RegisterProductBarcodePayload) (err error) {
+	unitSet := make(map[string]bool, len(units))
+
+	for i := range units {
+		if units[i].Unit == "" || units[i].Unit == baseUnit || unitSet[units[i].Unit] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid or duplicate unit %q", units[i].Unit)
+			return
+		}
+
+		if units[i].ConversionFactor <= 0 {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: conversion factor of unit %s must be positive", units[i].Unit)
+			return
+		}
+
+		unitSet[units[i].Unit] = true
+	}
+
+	barcodeSet := make(map[string]bool, len(barcodes))
+
+	for i := range barcodes {
+		if barcodeSet[barcodes[i].Barcode] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: duplicate barcode %s", barcodes[i].Barcode)
+			return
+		}
+
+		barcodeSet[barcodes[i].Barcode] = true
+
+		var valid bool
+
+		switch barcodes[i].BarcodeType {
+		case constants.ProductBarcodeTypeEAN13:
+			valid = utility.IsValidGTIN(barcodes[i].Barcode, 13)
+		case constants.ProductBarcodeTypeUPC:
+			valid = utility.IsValidGTIN(barcodes[i].Barcode, 12)
+		case constants.ProductBarcodeTypeCode128:
+			// Code 128 covers the printable ASCII range
+			valid = len(barcodes[i].Barcode) <= 80 && govalidator.IsPrintableASCII(barcodes[i].Barcode)
+		case constants.ProductBarcodeTypeQR:
+			valid = len(barcodes[i].Barcode) <= 1024
+		default:
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: invalid barcode type %q", barcodes[i].BarcodeType)
+			return
+		}
+
+		if !valid {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s is not a valid %s barcode", barcodes[i].Barcode, barcodes[i].BarcodeType)
+			return
+		}
+	}
+
 	return
 }
 
@@ -114,13 +274,28 @@ func (payload *RegisterProductPayload) ToEntity(userData sqlc.GetUserBackofficeR
 			String: payload.ProductPictureUrl,
 			Valid:  true,
 		},
-		Description: payload.Description,
-		CreatedBy:   userData.Guid,
+		Description:  payload.Description,
+		IsSerialized: payload.IsSerialized,
+		Sku: sql.NullString{
+			String: payload.Sku,
+			Valid:  true,
+		},
+		BaseUnit:      payload.BaseUnit,
+		CostingMethod: payload.CostingMethod,
+		CreatedBy:     userData.Guid,
 	}
 
 	return
 }
 
+func (payload *RegisterProductPayload) ToEntityBarcode(userData sqlc.GetUserBackofficeRow) (data []sqlc.InsertProductBarcodeParams) {
+	return toEntityProductBarcode(payload.Barcodes, userData)
+}
+
+func (payload *RegisterProductPayload) ToEntityUnit(userData sqlc.GetUserBackofficeRow) (data []sqlc.InsertProductUnitParams) {
+	return toEntityProductUnit(payload.Units, userData)
+}
+
 func (payload *UpdateProductPayload) ToEntity(userData sqlc.GetUserBackofficeRow, guid string) (data sqlc.UpdateProductParams) {
 	data = sqlc.UpdateProductParams{
 		Guid: guid,
@@ -132,8 +307,71 @@ func (payload *UpdateProductPayload) ToEntity(userData sqlc.GetUserBackofficeRow
 			String: payload.ProductPictureUrl,
 			Valid:  true,
 		},
-		Description: payload.Description,
-		UpdatedBy:   sql.NullString{String: userData.Guid, Valid: true},
+		Description:  payload.Description,
+		IsSerialized: payload.IsSerialized,
+		Sku: sql.NullString{
+			String: payload.Sku,
+			Valid:  true,
+		},
+		BaseUnit:  payload.BaseUnit,
+		UpdatedBy: sql.NullString{String: userData.Guid, Valid: true},
+	}
+
+	// An empty costing method keeps the current one
+	if payload.CostingMethod != nil {
+		data.CostingMethod = *payload.CostingMethod
+	}
+
+	return
+}
+
+func (payload *UpdateProductPayload) ToEntityBarcode(userData sqlc.GetUserBackofficeRow) (data []sqlc.InsertProductBarcodeParams) {
+	if payload.Barcodes == nil {
+		return nil
+	}
+
+	return toEntityProductBarcode(payload.Barcodes, userData)
+}
+
+func (payload *UpdateProductPayload) ToEntityUnit(userData sqlc.GetUserBackofficeRow) (data []sqlc.InsertProductUnitParams) {
+	if payload.Units == nil {
+		return nil
+	}
+
+	return toEntityProductUnit(payload.Units, userData)
+}
+
+// toEntityProductBarcode leaves ProductGuid empty, the service fills it in.
+func toEntityProductBarcode(barcodes []RegisterProductBarcodePayload, userData sqlc.GetUserBackofficeRow) (data []sqlc.InsertProductBarcodeParams) {
+	data = make([]sqlc.InsertProductBarcodeParams, len(barcodes))
+
+	for i := range barcodes {
+		data[i] = sqlc.InsertProductBarcodeParams{
+			Guid:        utility.GenerateGoogleUUID(),
+			Barcode:     barcodes[i].Barcode,
+			BarcodeType: barcodes[i].BarcodeType,
+			Unit: sql.NullString{
+				String: barcodes[i].Unit,
+				Valid:  barcodes[i].Unit != "",
+			},
+			CreatedBy: userData.Guid,
+		}
+	}
+
+	return
+}
+
+// toEntityProductUnit leaves ProductGuid empty, the service fills it in.
+func toEntityProductUnit(units []RegisterProductUnitPayload, userData sqlc.GetUserBackofficeRow) (data []sqlc.InsertProductUnitParams) {
+	data = make([]sqlc.InsertProductUnitParams, len(units))
+
+	for i := range units {
+		data[i] = sqlc.InsertProductUnitParams{
+			Guid:             utility.GenerateGoogleUUID(),
+			Unit:             units[i].Unit,
+			ConversionFactor: units[i].ConversionFactor,
+			CreatedBy:        userData.Guid,
+		}
 	}
 
 	return
@@ -143,9 +381,12 @@ func (payload *ListProductPayload) ToEntity() (data sqlc.ListProductParams) {
 	orderParam := constants.DefaultOrderValue
 
 	data = sqlc.ListProductParams{
-		SetName:   payload.Filter.SetName,
-		Name:      "%" + payload.Filter.Name + "%",
-		LimitData: payload.Limit,
+		SetName:            payload.Filter.SetName,
+		Name:               "%" + payload.Filter.Name + "%",
+		SetCategory:        payload.Filter.SetCategory,
+		CategoryGuid:       payload.Filter.CategoryID,
+		IncludeSubCategory: payload.Filter.IncludeSubCategory,
+		LimitData:          payload.Limit,
 	}
 
 	if payload.Limit == 0 {
@@ -171,15 +412,22 @@ func (payload *ListProductPayload) ToEntity() (data sqlc.ListProductParams) {
 	return
 }
 
-func ToPayloadRegisterProduct(productData sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow) (payload readRegisterProductPayload) {
+func ToPayloadRegisterProduct(productData sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit) (payload readRegisterProductPayload) {
 	payload = readRegisterProductPayload{
-		GUID:        productData.Guid,
-		Name:        productData.Name.String,
-		Description: productData.Description,
-		CreatedAt:   productData.CreatedAt,
+		GUID:          productData.Guid,
+		Name:          productData.Name.String,
+		Sku:           productData.Sku.String,
+		BaseUnit:      productData.BaseUnit,
+		CostingMethod: productData.CostingMethod,
+		Description:   productData.Description,
+		IsSerialized:  productData.IsSerialized,
+		CreatedAt:     productData.CreatedAt,
 		CreatedBy: readUserBackOfficePayload{
 			GUID: userBackoffice.Guid,
 		},
+		Categories: toPayloadProductCategoryLink(listCategory),
+		Barcodes:   toPayloadProductBarcode(listBarcode),
+		Units:      toPayloadProductUnit(listUnit),
 	}
 
 	if userBackoffice.Name.Valid {
@@ -193,15 +441,22 @@ func ToPayloadRegisterProduct(productData sqlc.Product, userBackoffice sqlc.GetU
 	return
 }
 
-func ToPayloadUpdateProduct(productData sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow) (payload readUpdateProductPayload) {
+func ToPayloadUpdateProduct(productData sqlc.Product, userBackoffice sqlc.GetUserBackofficeRow, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit) (payload readUpdateProductPayload) {
 	payload = readUpdateProductPayload{
-		GUID:        productData.Guid,
-		Name:        productData.Name.String,
-		Description: productData.Description,
-		UpdatedAt:   productData.UpdatedAt.Time,
+		GUID:          productData.Guid,
+		Name:          productData.Name.String,
+		Sku:           productData.Sku.String,
+		BaseUnit:      productData.BaseUnit,
+		CostingMethod: productData.CostingMethod,
+		Description:   productData.Description,
+		IsSerialized:  productData.IsSerialized,
+		UpdatedAt:     productData.UpdatedAt.Time,
 		UpdatedBy: readUserBackOfficePayload{
 			GUID: userBackoffice.Guid,
 		},
+		Categories: toPayloadProductCategoryLink(listCategory),
+		Barcodes:   toPayloadProductBarcode(listBarcode),
+		Units:      toPayloadProductUnit(listUnit),
 	}
 
 	if userBackoffice.Name.Valid {
@@ -221,15 +476,22 @@ func ToPayloadUpdateProduct(productData sqlc.Product, userBackoffice sqlc.GetUse
 	return
 }
 
-func ToPayloadProduct(productData sqlc.GetProductRow) (payload readProductPayload) {
+func ToPayloadProduct(productData sqlc.GetProductRow, listCategory []sqlc.ListProductCategoryByProductRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit) (payload readProductPayload) {
 	payload = readProductPayload{
-		GUID:        productData.Guid,
-		Name:        productData.Name.String,
-		Description: productData.Description,
-		CreatedAt:   productData.CreatedAt,
+		GUID:          productData.Guid,
+		Name:          productData.Name.String,
+		Sku:           productData.Sku.String,
+		BaseUnit:      productData.BaseUnit,
+		CostingMethod: productData.CostingMethod,
+		Description:   productData.Description,
+		IsSerialized:  productData.IsSerialized,
+		CreatedAt:     productData.CreatedAt,
 		CreatedBy: readUserBackOfficePayload{
 			GUID: productData.UserID.String,
 		},
+		Categories: toPayloadProductCategoryLink(listCategory),
+		Barcodes:   toPayloadProductBarcode(listBarcode),
+		Units:      toPayloadProductUnit(listUnit),
 	}
 
 	if productData.UserID.Valid {
@@ -264,14 +526,92 @@ func ToPayloadProduct(productData sqlc.GetProductRow) (payload readProductPayloa
 	return
 }
 
-func ToPayloadListProduct(listProduct []sqlc.ListProductRow) (payload []*readProductPayload) {
+func ToPayloadListProduct(listProduct []sqlc.ListProductRow, listCategory []sqlc.ListProductCategoryByProductsRow, listBarcode []sqlc.ProductBarcode, listUnit []sqlc.ProductUnit) (payload []*readProductPayload) {
 	payload = make([]*readProductPayload, len(listProduct))
 
+	productCategory := make(map[string][]sqlc.ListProductCategoryByProductRow)
+	for i := range listCategory {
+		productCategory[listCategory[i].ProductGuid] = append(productCategory[listCategory[i].ProductGuid], sqlc.ListProductCategoryByProductRow(listCategory[i]))
+	}
+
+	productBarcode := make(map[string][]sqlc.ProductBarcode)
+	for i := range listBarcode {
+		productBarcode[listBarcode[i].ProductGuid] = append(productBarcode[listBarcode[i].ProductGuid], listBarcode[i])
+	}
+
+	productUnit := make(map[string][]sqlc.ProductUnit)
+	for i := range listUnit {
+		productUnit[listUnit[i].ProductGuid] = append(productUnit[listUnit[i].ProductGuid], listUnit[i])
+	}
+
 	for i := range listProduct {
+		guid := listProduct[i].Guid
+
 		payload[i] = new(readProductPayload)
-		data := ToPayloadProduct(sqlc.GetProductRow(listProduct[i]))
+		data := ToPayloadProduct(sqlc.GetProductRow(listProduct[i]), productCategory[guid], productBarcode[guid], productUnit[guid])
 		payload[i] = &data
 	}
 
 	return
 }
+
+// ToPayloadProductBarcodeLookup describes a scanned barcode: the product it belongs to and
+// how many base units one scan stands for.
+func ToPayloadProductBarcodeLookup(productBarcode sqlc.ProductBarcode, conversionFactor int64, product readProductPayload) (payload readProductBarcodeLookupPayload) {
+	payload = readProductBarcodeLookupPayload{
+		Barcode:          productBarcode.Barcode,
+		BarcodeType:      productBarcode.BarcodeType,
+		Unit:             product.BaseUnit,
+		ConversionFactor: conversionFactor,
+		Product:          product,
+	}
+
+	if productBarcode.Unit.Valid {
+		payload.Unit = productBarcode.Unit.String
+	}
+
+	return
+}
+
+func toPayloadProductBarcode(listBarcode []sqlc.ProductBarcode) (payload []readProductBarcodePayload) {
+	payload = make([]readProductBarcodePayload, len(listBarcode))
+
+	for i := range listBarcode {
+		payload[i] = readProductBarcodePayload{
+			Barcode:     listBarcode[i].Barcode,
+			BarcodeType: listBarcode[i].BarcodeType,
+		}
+
+		if listBarcode[i].Unit.Valid {
+			payload[i].Unit = &listBarcode[i].Unit.String
+		}
+	}
+
+	return
+}
+
+func toPayloadProductUnit(listUnit []sqlc.ProductUnit) (payload []readProductUnitPayload) {
+	payload = make([]readProductUnitPayload, len(listUnit))
+
+	for i := range listUnit {
+		payload[i] = readProductUnitPayload{
+			Unit:             listUnit[i].Unit,
+			ConversionFactor: listUnit[i].ConversionFactor,
+		}
+	}
+
+	return
+}
+
+func toPayloadProductCategoryLink(listCategory []sqlc.ListProductCategoryByProductRow) (payload []readProductCategoryLinkPayload) {
+	payload = make([]readProductCategoryLinkPayload, len(listCategory))
+
+	for i := range listCategory {
+		payload[i] = readProductCategoryLinkPayload{
+			GUID: listCategory[i].Guid,
+			Name: listCategory[i].Name,
+		}
+	}
+
+	return
+}
@@ -0,0 +1,116 @@
+package payload
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateProductUnitBarcode(t *testing.T) {
+	units := []RegisterProductUnitPayload{{Unit: "box", ConversionFactor: 12}, {Unit: "carton", ConversionFactor: 144}}
+
+	tests := []struct {
+		name     string
+		baseUnit string
+		units    []RegisterProductUnitPayload
+		barcodes []RegisterProductBarcodePayload
+		wantErr  bool
+	}{
+		{
+			name:     "accepts units and one barcode of every type",
+			baseUnit: "pcs",
+			units:    units,
+			barcodes: []RegisterProductBarcodePayload{
+				{Barcode: "4006381333931", BarcodeType: "ean13"},
+				{Barcode: "036000291452", BarcodeType: "upc", Unit: "box"},
+				{Barcode: "CARTON-0001", BarcodeType: "code128", Unit: "carton"},
+				{Barcode: "https://example.com/p/1", BarcodeType: "qr"},
+			},
+		},
+		{
+			name:     "accepts nothing to validate",
+			baseUnit: "pcs",
+		},
+		{
+			name:     "leaves the base unit check to the service when the update keeps it",
+			baseUnit: "",
+			units:    []RegisterProductUnitPayload{{Unit: "pcs", ConversionFactor: 1}},
+		},
+		{
+			name:     "rejects an empty unit",
+			baseUnit: "pcs",
+			units:    []RegisterProductUnitPayload{{Unit: "", ConversionFactor: 12}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a unit repeating the base unit",
+			baseUnit: "pcs",
+			units:    []RegisterProductUnitPayload{{Unit: "pcs", ConversionFactor: 1}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a duplicate unit",
+			baseUnit: "pcs",
+			units:    []RegisterProductUnitPayload{{Unit: "box", ConversionFactor: 12}, {Unit: "box", ConversionFactor: 24}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a conversion factor that is not positive",
+			baseUnit: "pcs",
+			units:    []RegisterProductUnitPayload{{Unit: "box", ConversionFactor: 0}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a duplicate barcode",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{
+				{Barcode: "4006381333931", BarcodeType: "ean13"},
+				{Barcode: "4006381333931", BarcodeType: "ean13", Unit: "box"},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "rejects an ean13 barcode with a wrong check digit",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{{Barcode: "4006381333932", BarcodeType: "ean13"}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects an upc barcode of the wrong length",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{{Barcode: "4006381333931", BarcodeType: "upc"}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a code128 barcode outside printable ascii",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{{Barcode: "CARTON\t0001", BarcodeType: "code128"}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a code128 barcode that is too long",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{{Barcode: strings.Repeat("A", 81), BarcodeType: "code128"}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects a qr code that is too long",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{{Barcode: strings.Repeat("A", 1025), BarcodeType: "qr"}},
+			wantErr:  true,
+		},
+		{
+			name:     "rejects an unknown barcode type",
+			baseUnit: "pcs",
+			barcodes: []RegisterProductBarcodePayload{{Barcode: "12345", BarcodeType: "ean8"}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateProductUnitBarcode(tt.baseUnit, tt.units, tt.barcodes); (err != nil) != tt.wantErr {
+				t.Errorf("validateProductUnitBarcode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
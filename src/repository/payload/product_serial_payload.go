@@ -0,0 +1,63 @@
+package payload
+
+import (
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type readProductSerialPayload struct {
+	SerialNumber string                             `json:"serial_number"`
+	ProductID    string                             `json:"product_id"`
+	WarehouseID  *string                            `json:"warehouse_id"`
+	Status       string                             `json:"status"`
+	History      []*readProductSerialHistoryPayload `json:"history"`
+	CreatedAt    time.Time                          `json:"created_at"`
+	UpdatedAt    *time.Time                         `json:"updated_at"`
+}
+
+type readProductSerialHistoryPayload struct {
+	ProductHistoryID string    `json:"product_history_id"`
+	WarehouseID      string    `json:"warehouse_id"`
+	WarehouseName    string    `json:"warehouse_name"`
+	HistoryType      string    `json:"history_type"`
+	ReferenceType    *string   `json:"reference_type"`
+	ReferenceID      *string   `json:"reference_id"`
+	CreatedAt        time.Time `json:"created_at"`
+	CreatedBy        string    `json:"created_by"`
+}
+
+func ToPayloadProductSerial(productSerialData sqlc.ProductSerial, listHistory []sqlc.ListProductSerialHistoryRow) (payload readProductSerialPayload) {
+	payload = readProductSerialPayload{
+		SerialNumber: productSerialData.SerialNumber,
+		ProductID:    productSerialData.ProductGuid,
+		Status:       productSerialData.Status,
+		History:      make([]*readProductSerialHistoryPayload, len(listHistory)),
+		CreatedAt:    productSerialData.CreatedAt,
+	}
+
+	if productSerialData.WarehouseGuid.Valid {
+		payload.WarehouseID = &productSerialData.WarehouseGuid.String
+	}
+
+	if productSerialData.UpdatedAt.Valid {
+		payload.UpdatedAt = &productSerialData.UpdatedAt.Time
+	}
+
+	for i := range listHistory {
+		payload.History[i] = &readProductSerialHistoryPayload{
+			ProductHistoryID: listHistory[i].Guid,
+			WarehouseID:      listHistory[i].WarehouseGuid,
+			WarehouseName:    listHistory[i].WarehouseName.String,
+			HistoryType:      listHistory[i].HistoryType,
+			CreatedAt:        listHistory[i].CreatedAt,
+			CreatedBy:        listHistory[i].CreatedBy,
+		}
+
+		if listHistory[i].ReferenceType.Valid {
+			payload.History[i].ReferenceType = &listHistory[i].ReferenceType.String
+			payload.History[i].ReferenceID = &listHistory[i].ReferenceGuid.String
+		}
+	}
+
+	return
+}
@@ -0,0 +1,370 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type InsertPurchaseOrderPayload struct {
+	SupplierName string                           `json:"supplier_name" valid:"required"`
+	WarehouseID  string                           `json:"warehouse_id" valid:"required"`
+	ExpectedDate time.Time                        `json:"expected_date" valid:"required"`
+	Notes        string                           `json:"notes"`
+	Items        []InsertPurchaseOrderItemPayload `json:"items" valid:"required"`
+}
+
+type InsertPurchaseOrderItemPayload struct {
+	ProductID string `json:"product_id" valid:"required"`
+	Quantity  int64  `json:"quantity" valid:"required"`
+	UnitCost  *int64 `json:"unit_cost"` // purchase price of one base unit
+}
+
+type ReceivePurchaseOrderPayload struct {
+	Items []ReceivePurchaseOrderItemPayload `json:"items" valid:"required"`
+}
+
+type ReceivePurchaseOrderItemPayload struct {
+	ItemID     string     `json:"item_id" valid:"required"`
+	BinID      string     `json:"bin_id"`
+	LotNumber  string     `json:"lot_number"`
+	ExpiryDate *time.Time `json:"expiry_date"`
+	Quantity   int64      `json:"quantity" valid:"required"`
+	UnitCost   *int64     `json:"unit_cost"` // empty uses the unit cost of the order item
+	// SerialNumbers lists one serial per unit for serialized products
+	SerialNumbers []string `json:"serial_numbers"`
+}
+
+type ListPurchaseOrderPayload struct {
+	Filter ListPurchaseOrderFilterPayload `json:"filter"`
+	Limit  int32                          `json:"limit" valid:"required"`
+	Offset int32                          `json:"page" valid:"required"`
+	Order  string                         `json:"order" valid:"required"`
+	Sort   string                         `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListPurchaseOrderFilterPayload struct {
+	SetPoNumber     bool   `json:"set_po_number"`
+	PoNumber        string `json:"po_number"`
+	SetSupplierName bool   `json:"set_supplier_name"`
+	SupplierName    string `json:"supplier_name"`
+	SetStatus       bool   `json:"set_status"`
+	Status          string `json:"status"` // open, partially_received, closed
+	SetWarehouse    bool   `json:"set_warehouse"`
+	WarehouseID     string `json:"warehouse_id"`
+}
+
+type readPurchaseOrderPayload struct {
+	GUID          string                          `json:"id"`
+	PoNumber      string                          `json:"po_number"`
+	SupplierName  string                          `json:"supplier_name"`
+	WarehouseID   string                          `json:"warehouse_id"`
+	WarehouseCode string                          `json:"warehouse_code"`
+	WarehouseName string                          `json:"warehouse_name"`
+	ExpectedDate  time.Time                       `json:"expected_date"`
+	Status        string                          `json:"status"`
+	Notes         string                          `json:"notes"`
+	Items         []*readPurchaseOrderItemPayload `json:"items,omitempty"`
+	ClosedAt      *time.Time                      `json:"closed_at"`
+	CreatedAt     time.Time                       `json:"created_at"`
+	CreatedBy     string                          `json:"created_by"`
+	UpdatedAt     *time.Time                      `json:"updated_at"`
+	UpdatedBy     *string                         `json:"updated_by"`
+}
+
+type readPurchaseOrderItemPayload struct {
+	GUID              string `json:"id"`
+	ProductID         string `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	QuantityOrdered   int64  `json:"quantity_ordered"`
+	QuantityReceived  int64  `json:"quantity_received"`
+	QuantityRemaining int64  `json:"quantity_remaining"`
+	UnitCost          *int64 `json:"unit_cost"`
+}
+
+func (payload *InsertPurchaseOrderPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if len(payload.Items) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: items is required")
+		return
+	}
+
+	products := make(map[string]bool, len(payload.Items))
+	for _, item := range payload.Items {
+		if item.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+
+		if item.UnitCost != nil && *item.UnitCost < 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: unit cost must not be negative")
+			return
+		}
+
+		if products[item.ProductID] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: duplicate product %s", item.ProductID)
+			return
+		}
+
+		products[item.ProductID] = true
+	}
+
+	return
+}
+
+func (payload *ReceivePurchaseOrderPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if len(payload.Items) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: items is required")
+		return
+	}
+
+	for _, item := range payload.Items {
+		if item.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+
+		if item.ExpiryDate != nil && item.LotNumber == "" {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: expiry date requires a lot number")
+			return
+		}
+
+		if item.UnitCost != nil && *item.UnitCost < 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: unit cost must not be negative")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListPurchaseOrderPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.PurchaseOrderStatusOpen, constants.PurchaseOrderStatusPartiallyReceived, constants.PurchaseOrderStatusClosed:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *InsertPurchaseOrderPayload) ToEntity(userGUID string) (data sqlc.InsertPurchaseOrderParams) {
+	data = sqlc.InsertPurchaseOrderParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		PoNumber:      utility.GenerateDocumentNumber(constants.PurchaseOrderCodePrefix),
+		SupplierName:  payload.SupplierName,
+		WarehouseGuid: payload.WarehouseID,
+		ExpectedDate:  payload.ExpectedDate,
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+	}
+
+	return
+}
+
+func (payload *InsertPurchaseOrderPayload) ToEntityItems(purchaseOrderGUID string) (data []sqlc.InsertPurchaseOrderItemParams) {
+	data = make([]sqlc.InsertPurchaseOrderItemParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[i] = sqlc.InsertPurchaseOrderItemParams{
+			Guid:              utility.GenerateGoogleUUID(),
+			PurchaseOrderGuid: purchaseOrderGUID,
+			ProductGuid:       payload.Items[i].ProductID,
+			QuantityOrdered:   payload.Items[i].Quantity,
+		}
+
+		if payload.Items[i].UnitCost != nil {
+			data[i].UnitCost = sql.NullInt64{
+				Int64: *payload.Items[i].UnitCost,
+				Valid: true,
+			}
+		}
+	}
+
+	return
+}
+
+func (payload *ReceivePurchaseOrderPayload) ToEntity(purchaseOrderGUID string) (data []sqlc.ReceivePurchaseOrderItemParams) {
+	data = make([]sqlc.ReceivePurchaseOrderItemParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[i] = sqlc.ReceivePurchaseOrderItemParams{
+			QuantityReceived:  payload.Items[i].Quantity,
+			Guid:              payload.Items[i].ItemID,
+			PurchaseOrderGuid: purchaseOrderGUID,
+		}
+	}
+
+	return
+}
+
+func (payload *ListPurchaseOrderPayload) ToEntity() (data sqlc.ListPurchaseOrderParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListPurchaseOrderParams{
+		SetPoNumber:     payload.Filter.SetPoNumber,
+		PoNumber:        "%" + payload.Filter.PoNumber + "%",
+		SetSupplierName: payload.Filter.SetSupplierName,
+		SupplierName:    "%" + payload.Filter.SupplierName + "%",
+		SetStatus:       payload.Filter.SetStatus,
+		Status:          payload.Filter.Status,
+		SetWarehouse:    payload.Filter.SetWarehouse,
+		WarehouseGuid:   payload.Filter.WarehouseID,
+		LimitData:       payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadPurchaseOrder(purchaseOrderData sqlc.GetPurchaseOrderRow, listItem []sqlc.ListPurchaseOrderItemRow) (payload readPurchaseOrderPayload) {
+	payload = readPurchaseOrderPayload{
+		GUID:          purchaseOrderData.Guid,
+		PoNumber:      purchaseOrderData.PoNumber,
+		SupplierName:  purchaseOrderData.SupplierName,
+		WarehouseID:   purchaseOrderData.WarehouseGuid,
+		WarehouseCode: purchaseOrderData.WarehouseCode.String,
+		WarehouseName: purchaseOrderData.WarehouseName.String,
+		ExpectedDate:  purchaseOrderData.ExpectedDate,
+		Status:        purchaseOrderData.Status,
+		Notes:         purchaseOrderData.Notes.String,
+		CreatedAt:     purchaseOrderData.CreatedAt,
+		CreatedBy:     purchaseOrderData.CreatedBy,
+	}
+
+	if purchaseOrderData.ClosedAt.Valid {
+		payload.ClosedAt = &purchaseOrderData.ClosedAt.Time
+	}
+
+	if purchaseOrderData.UpdatedAt.Valid {
+		payload.UpdatedAt = &purchaseOrderData.UpdatedAt.Time
+		payload.UpdatedBy = &purchaseOrderData.UpdatedBy.String
+	}
+
+	if listItem != nil {
+		payload.Items = make([]*readPurchaseOrderItemPayload, len(listItem))
+
+		for i := range listItem {
+			payload.Items[i] = &readPurchaseOrderItemPayload{
+				GUID:              listItem[i].Guid,
+				ProductID:         listItem[i].ProductGuid,
+				ProductName:       listItem[i].ProductName.String,
+				QuantityOrdered:   listItem[i].QuantityOrdered,
+				QuantityReceived:  listItem[i].QuantityReceived,
+				QuantityRemaining: listItem[i].QuantityOrdered - listItem[i].QuantityReceived,
+			}
+
+			if listItem[i].UnitCost.Valid {
+				payload.Items[i].UnitCost = &listItem[i].UnitCost.Int64
+			}
+		}
+	}
+
+	return
+}
+
+func ToPayloadListPurchaseOrder(listPurchaseOrder []sqlc.ListPurchaseOrderRow) (payload []*readPurchaseOrderPayload) {
+	payload = make([]*readPurchaseOrderPayload, len(listPurchaseOrder))
+
+	for i := range listPurchaseOrder {
+		payload[i] = new(readPurchaseOrderPayload)
+		data := ToPayloadPurchaseOrder(sqlc.GetPurchaseOrderRow(listPurchaseOrder[i]), nil)
+		payload[i] = &data
+	}
+
+	return
+}
+
+// ToEntitySerial maps each item to the serial numbers received with it.
+func (payload *ReceivePurchaseOrderPayload) ToEntitySerial() (data map[string][]string) {
+	data = make(map[string][]string, len(payload.Items))
+
+	for i := range payload.Items {
+		data[payload.Items[i].ItemID] = payload.Items[i].SerialNumbers
+	}
+
+	return
+}
+
+// ToEntityMovement maps each item to the bin, lot, expiry date and unit cost its receipt is booked with.
+func (payload *ReceivePurchaseOrderPayload) ToEntityMovement() (data map[string]sqlc.InsertProductsHistoryParams) {
+	data = make(map[string]sqlc.InsertProductsHistoryParams, len(payload.Items))
+
+	for i := range payload.Items {
+		movement := sqlc.InsertProductsHistoryParams{
+			BinGuid: sql.NullString{
+				String: payload.Items[i].BinID,
+				Valid:  payload.Items[i].BinID != "",
+			},
+			LotNumber: sql.NullString{
+				String: payload.Items[i].LotNumber,
+				Valid:  payload.Items[i].LotNumber != "",
+			},
+		}
+
+		if payload.Items[i].ExpiryDate != nil {
+			movement.ExpiryDate = sql.NullTime{
+				Time:  *payload.Items[i].ExpiryDate,
+				Valid: true,
+			}
+		}
+
+		if payload.Items[i].UnitCost != nil {
+			movement.UnitCost = sql.NullInt64{
+				Int64: *payload.Items[i].UnitCost,
+				Valid: true,
+			}
+		}
+
+		data[payload.Items[i].ItemID] = movement
+	}
+
+	return
+}
@@ -0,0 +1,422 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type InsertSalesOrderPayload struct {
+	CustomerName    string `json:"customer_name"` // empty uses the customer name
+	ShippingAddress string `json:"shipping_address"`
+	// CustomerID links the order to the customer master, the shipping address defaults to
+	// the customer default address
+	CustomerID        string                        `json:"customer_id"`
+	CustomerAddressID string                        `json:"customer_address_id"`
+	Notes             string                        `json:"notes"`
+	Items             []InsertSalesOrderItemPayload `json:"items" valid:"required"`
+}
+
+type InsertSalesOrderItemPayload struct {
+	ProductID   string `json:"product_id" valid:"required"`
+	WarehouseID string `json:"warehouse_id" valid:"required"`
+	Quantity    int64  `json:"quantity" valid:"required"`
+}
+
+type PickSalesOrderPayload struct {
+	Items []PickSalesOrderItemPayload `json:"items" valid:"required"`
+}
+
+type PickSalesOrderItemPayload struct {
+	ItemID    string `json:"item_id" valid:"required"`
+	BinID     string `json:"bin_id"`
+	LotNumber string `json:"lot_number"` // empty picks first-expiry-first-out
+	Quantity  int64  `json:"quantity" valid:"required"`
+	// SerialNumbers lists the scanned serials for serialized products
+	SerialNumbers []string `json:"serial_numbers"`
+}
+
+type ListSalesOrderPayload struct {
+	Filter ListSalesOrderFilterPayload `json:"filter"`
+	Limit  int32                       `json:"limit" valid:"required"`
+	Offset int32                       `json:"page" valid:"required"`
+	Order  string                      `json:"order" valid:"required"`
+	Sort   string                      `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListSalesOrderFilterPayload struct {
+	SetOrderNumber  bool   `json:"set_order_number"`
+	OrderNumber     string `json:"order_number"`
+	SetCustomerName bool   `json:"set_customer_name"`
+	CustomerName    string `json:"customer_name"`
+	SetStatus       bool   `json:"set_status"`
+	Status          string `json:"status"` // open, partially_shipped, closed, cancelled
+	SetWarehouse    bool   `json:"set_warehouse"`
+	WarehouseID     string `json:"warehouse_id"`
+}
+
+type ListPickListPayload struct {
+	Limit  int32 `query:"limit"`
+	Offset int32 `query:"page"`
+}
+
+type readSalesOrderPayload struct {
+	GUID              string                       `json:"id"`
+	OrderNumber       string                       `json:"order_number"`
+	CustomerName      string                       `json:"customer_name"`
+	ShippingAddress   string                       `json:"shipping_address"`
+	CustomerID        *string                      `json:"customer_id"`
+	CustomerAddressID *string                      `json:"customer_address_id"`
+	Status            string                       `json:"status"`
+	Notes             string                       `json:"notes"`
+	Items             []*readSalesOrderItemPayload `json:"items,omitempty"`
+	ClosedAt          *time.Time                   `json:"closed_at"`
+	CreatedAt         time.Time                    `json:"created_at"`
+	CreatedBy         string                       `json:"created_by"`
+	UpdatedAt         *time.Time                   `json:"updated_at"`
+	UpdatedBy         *string                      `json:"updated_by"`
+}
+
+type readSalesOrderItemPayload struct {
+	GUID              string `json:"id"`
+	ProductID         string `json:"product_id"`
+	ProductName       string `json:"product_name"`
+	WarehouseID       string `json:"warehouse_id"`
+	WarehouseCode     string `json:"warehouse_code"`
+	WarehouseName     string `json:"warehouse_name"`
+	QuantityOrdered   int64  `json:"quantity_ordered"`
+	QuantityShipped   int64  `json:"quantity_shipped"`
+	QuantityRemaining int64  `json:"quantity_remaining"`
+	QuantityReserved  int64  `json:"quantity_reserved"`
+}
+
+type readPickListPayload struct {
+	ItemID         string    `json:"item_id"`
+	SalesOrderID   string    `json:"sales_order_id"`
+	OrderNumber    string    `json:"order_number"`
+	CustomerName   string    `json:"customer_name"`
+	ProductID      string    `json:"product_id"`
+	ProductName    string    `json:"product_name"`
+	WarehouseID    string    `json:"warehouse_id"`
+	QuantityToPick int64     `json:"quantity_to_pick"`
+	OrderedAt      time.Time `json:"ordered_at"`
+}
+
+func (payload *InsertSalesOrderPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.CustomerName == "" && payload.CustomerID == "" {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: customer name or customer id is required")
+		return
+	}
+
+	if len(payload.Items) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: items is required")
+		return
+	}
+
+	for _, item := range payload.Items {
+		if item.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *PickSalesOrderPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if len(payload.Items) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: items is required")
+		return
+	}
+
+	// The bin, lot and serials of a pick are keyed by item, a second line would overwrite the first
+	items := make(map[string]bool, len(payload.Items))
+	for _, item := range payload.Items {
+		if item.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+
+		if items[item.ItemID] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: duplicate item %s", item.ItemID)
+			return
+		}
+
+		items[item.ItemID] = true
+	}
+
+	return
+}
+
+func (payload *ListSalesOrderPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.SalesOrderStatusOpen, constants.SalesOrderStatusPartiallyShipped, constants.SalesOrderStatusClosed, constants.SalesOrderStatusCancelled:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListPickListPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Limit == 0 {
+		payload.Limit = 10
+	}
+
+	if payload.Offset == 0 {
+		payload.Offset = 1
+	}
+
+	return
+}
+
+func (payload *InsertSalesOrderPayload) ToEntity(userGUID string) (data sqlc.InsertSalesOrderParams) {
+	data = sqlc.InsertSalesOrderParams{
+		Guid:         utility.GenerateGoogleUUID(),
+		OrderNumber:  utility.GenerateDocumentNumber(constants.SalesOrderCodePrefix),
+		CustomerName: payload.CustomerName,
+		ShippingAddress: sql.NullString{
+			String: payload.ShippingAddress,
+			Valid:  payload.ShippingAddress != "",
+		},
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+		CustomerGuid: sql.NullString{
+			String: payload.CustomerID,
+			Valid:  payload.CustomerID != "",
+		},
+		CustomerAddressGuid: sql.NullString{
+			String: payload.CustomerAddressID,
+			Valid:  payload.CustomerAddressID != "",
+		},
+	}
+
+	return
+}
+
+func (payload *InsertSalesOrderPayload) ToEntityItems(salesOrderGUID string) (data []sqlc.InsertSalesOrderItemParams) {
+	data = make([]sqlc.InsertSalesOrderItemParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[i] = sqlc.InsertSalesOrderItemParams{
+			Guid:            utility.GenerateGoogleUUID(),
+			SalesOrderGuid:  salesOrderGUID,
+			ProductGuid:     payload.Items[i].ProductID,
+			WarehouseGuid:   payload.Items[i].WarehouseID,
+			QuantityOrdered: payload.Items[i].Quantity,
+		}
+	}
+
+	return
+}
+
+func (payload *PickSalesOrderPayload) ToEntity(salesOrderGUID string) (data []sqlc.ShipSalesOrderItemParams) {
+	data = make([]sqlc.ShipSalesOrderItemParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[i] = sqlc.ShipSalesOrderItemParams{
+			QuantityShipped: payload.Items[i].Quantity,
+			Guid:            payload.Items[i].ItemID,
+			SalesOrderGuid:  salesOrderGUID,
+		}
+	}
+
+	return
+}
+
+func (payload *ListSalesOrderPayload) ToEntity() (data sqlc.ListSalesOrderParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListSalesOrderParams{
+		SetOrderNumber:  payload.Filter.SetOrderNumber,
+		OrderNumber:     "%" + payload.Filter.OrderNumber + "%",
+		SetCustomerName: payload.Filter.SetCustomerName,
+		CustomerName:    "%" + payload.Filter.CustomerName + "%",
+		SetStatus:       payload.Filter.SetStatus,
+		Status:          payload.Filter.Status,
+		SetWarehouse:    payload.Filter.SetWarehouse,
+		WarehouseGuid:   payload.Filter.WarehouseID,
+		LimitData:       payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func (payload *ListPickListPayload) ToEntity(warehouseGUID string) (data sqlc.ListSalesOrderPickListParams) {
+	data = sqlc.ListSalesOrderPickListParams{
+		WarehouseGuid: warehouseGUID,
+		OffsetPage:    (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:     payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadSalesOrder(salesOrderData sqlc.SalesOrder, listItem []sqlc.ListSalesOrderItemRow) (payload readSalesOrderPayload) {
+	payload = readSalesOrderPayload{
+		GUID:            salesOrderData.Guid,
+		OrderNumber:     salesOrderData.OrderNumber,
+		CustomerName:    salesOrderData.CustomerName,
+		ShippingAddress: salesOrderData.ShippingAddress.String,
+		Status:          salesOrderData.Status,
+		Notes:           salesOrderData.Notes.String,
+		CreatedAt:       salesOrderData.CreatedAt,
+		CreatedBy:       salesOrderData.CreatedBy,
+	}
+
+	if salesOrderData.CustomerGuid.Valid {
+		payload.CustomerID = &salesOrderData.CustomerGuid.String
+	}
+
+	if salesOrderData.CustomerAddressGuid.Valid {
+		payload.CustomerAddressID = &salesOrderData.CustomerAddressGuid.String
+	}
+
+	if salesOrderData.ClosedAt.Valid {
+		payload.ClosedAt = &salesOrderData.ClosedAt.Time
+	}
+
+	if salesOrderData.UpdatedAt.Valid {
+		payload.UpdatedAt = &salesOrderData.UpdatedAt.Time
+		payload.UpdatedBy = &salesOrderData.UpdatedBy.String
+	}
+
+	if listItem != nil {
+		payload.Items = make([]*readSalesOrderItemPayload, len(listItem))
+
+		for i := range listItem {
+			payload.Items[i] = &readSalesOrderItemPayload{
+				GUID:              listItem[i].Guid,
+				ProductID:         listItem[i].ProductGuid,
+				ProductName:       listItem[i].ProductName.String,
+				WarehouseID:       listItem[i].WarehouseGuid,
+				WarehouseCode:     listItem[i].WarehouseCode.String,
+				WarehouseName:     listItem[i].WarehouseName.String,
+				QuantityOrdered:   listItem[i].QuantityOrdered,
+				QuantityShipped:   listItem[i].QuantityShipped,
+				QuantityRemaining: listItem[i].QuantityOrdered - listItem[i].QuantityShipped,
+				QuantityReserved:  listItem[i].QuantityReserved,
+			}
+		}
+	}
+
+	return
+}
+
+func ToPayloadListSalesOrder(listSalesOrder []sqlc.SalesOrder) (payload []*readSalesOrderPayload) {
+	payload = make([]*readSalesOrderPayload, len(listSalesOrder))
+
+	for i := range listSalesOrder {
+		payload[i] = new(readSalesOrderPayload)
+		data := ToPayloadSalesOrder(listSalesOrder[i], nil)
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadListPickList(listPickList []sqlc.ListSalesOrderPickListRow) (payload []*readPickListPayload) {
+	payload = make([]*readPickListPayload, len(listPickList))
+
+	for i := range listPickList {
+		payload[i] = &readPickListPayload{
+			ItemID:         listPickList[i].Guid,
+			SalesOrderID:   listPickList[i].SalesOrderGuid,
+			OrderNumber:    listPickList[i].OrderNumber,
+			CustomerName:   listPickList[i].CustomerName,
+			ProductID:      listPickList[i].ProductGuid,
+			ProductName:    listPickList[i].ProductName.String,
+			WarehouseID:    listPickList[i].WarehouseGuid,
+			QuantityToPick: listPickList[i].QuantityOrdered - listPickList[i].QuantityShipped,
+			OrderedAt:      listPickList[i].CreatedAt,
+		}
+	}
+
+	return
+}
+
+// ToEntitySerial maps each item to the serial numbers scanned while picking it.
+func (payload *PickSalesOrderPayload) ToEntitySerial() (data map[string][]string) {
+	data = make(map[string][]string, len(payload.Items))
+
+	for i := range payload.Items {
+		data[payload.Items[i].ItemID] = payload.Items[i].SerialNumbers
+	}
+
+	return
+}
+
+// ToEntityMovement maps each item to the bin and lot it is picked from.
+func (payload *PickSalesOrderPayload) ToEntityMovement() (data map[string]sqlc.InsertKeluarProductsHistoryParams) {
+	data = make(map[string]sqlc.InsertKeluarProductsHistoryParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[payload.Items[i].ItemID] = sqlc.InsertKeluarProductsHistoryParams{
+			BinGuid: sql.NullString{
+				String: payload.Items[i].BinID,
+				Valid:  payload.Items[i].BinID != "",
+			},
+			LotNumber: sql.NullString{
+				String: payload.Items[i].LotNumber,
+				Valid:  payload.Items[i].LotNumber != "",
+			},
+		}
+	}
+
+	return
+}
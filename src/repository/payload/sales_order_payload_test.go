@@ -0,0 +1,47 @@
+package payload
+
+import (
+	"testing"
+)
+
+func TestPickSalesOrderPayload_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   []PickSalesOrderItemPayload
+		wantErr bool
+	}{
+		{
+			name: "accepts one line per item",
+			items: []PickSalesOrderItemPayload{
+				{ItemID: "item-1", LotNumber: "LOT-A", Quantity: 2},
+				{ItemID: "item-2", Quantity: 1, SerialNumbers: []string{"SN-1"}},
+			},
+		},
+		{
+			name:    "rejects an empty pick",
+			wantErr: true,
+		},
+		{
+			name:    "rejects a quantity that is not positive",
+			items:   []PickSalesOrderItemPayload{{ItemID: "item-1", Quantity: -1}},
+			wantErr: true,
+		},
+		{
+			name: "rejects an item picked on two lines",
+			items: []PickSalesOrderItemPayload{
+				{ItemID: "item-1", LotNumber: "LOT-A", Quantity: 2},
+				{ItemID: "item-1", LotNumber: "LOT-B", Quantity: 1},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := PickSalesOrderPayload{Items: tt.items}
+			if err := payload.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
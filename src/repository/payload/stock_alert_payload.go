@@ -0,0 +1,251 @@
+package payload
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type UpsertStockReorderPointPayload struct {
+	ProductID    string `json:"product_id" valid:"required"`
+	WarehouseID  string `json:"warehouse_id" valid:"required"`
+	MinQuantity  int64  `json:"min_quantity"`
+	MaxQuantity  int64  `json:"max_quantity" valid:"required"`
+	ReorderPoint int64  `json:"reorder_point" valid:"required"`
+}
+
+type ListStockReorderPointPayload struct {
+	Filter ListStockReorderPointFilterPayload `json:"filter"`
+	Limit  int32                              `json:"limit" valid:"required"`
+	Offset int32                              `json:"page" valid:"required"`
+}
+
+type ListStockReorderPointFilterPayload struct {
+	SetWarehouse      bool   `json:"set_warehouse"`
+	WarehouseID       string `json:"warehouse_id"`
+	SetProduct        bool   `json:"set_product"`
+	ProductID         string `json:"product_id"`
+	BelowReorderPoint bool   `json:"below_reorder_point"`
+}
+
+type ListStockAlertPayload struct {
+	Filter ListStockAlertFilterPayload `json:"filter"`
+	Limit  int32                       `json:"limit" valid:"required"`
+	Offset int32                       `json:"page" valid:"required"`
+}
+
+type ListStockAlertFilterPayload struct {
+	SetStatus    bool   `json:"set_status"`
+	Status       string `json:"status"` // open, acknowledged, resolved
+	SetWarehouse bool   `json:"set_warehouse"`
+	WarehouseID  string `json:"warehouse_id"`
+	SetProduct   bool   `json:"set_product"`
+	ProductID    string `json:"product_id"`
+}
+
+type readStockReorderPointPayload struct {
+	GUID           string     `json:"id"`
+	ProductID      string     `json:"product_id"`
+	ProductName    string     `json:"product_name"`
+	WarehouseID    string     `json:"warehouse_id"`
+	WarehouseCode  string     `json:"warehouse_code"`
+	WarehouseName  string     `json:"warehouse_name"`
+	MinQuantity    int64      `json:"min_quantity"`
+	MaxQuantity    int64      `json:"max_quantity"`
+	ReorderPoint   int64      `json:"reorder_point"`
+	OnHandQuantity int64      `json:"on_hand_quantity"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CreatedBy      string     `json:"created_by"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+	UpdatedBy      *string    `json:"updated_by"`
+}
+
+type readStockAlertPayload struct {
+	GUID              string     `json:"id"`
+	ProductID         string     `json:"product_id"`
+	ProductName       string     `json:"product_name"`
+	WarehouseID       string     `json:"warehouse_id"`
+	WarehouseCode     string     `json:"warehouse_code"`
+	WarehouseName     string     `json:"warehouse_name"`
+	AlertType         string     `json:"alert_type"`
+	OnHandQuantity    int64      `json:"on_hand_quantity"`
+	ReorderPoint      int64      `json:"reorder_point"`
+	SuggestedQuantity int64      `json:"suggested_quantity"`
+	Status            string     `json:"status"`
+	NotifiedAt        *time.Time `json:"notified_at"`
+	AcknowledgedAt    *time.Time `json:"acknowledged_at"`
+	AcknowledgedBy    *string    `json:"acknowledged_by"`
+	ResolvedAt        *time.Time `json:"resolved_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+func (payload *UpsertStockReorderPointPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.MinQuantity < 0 || payload.ReorderPoint < payload.MinQuantity || payload.MaxQuantity < payload.ReorderPoint {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantities must satisfy 0 <= min_quantity <= reorder_point <= max_quantity")
+		return
+	}
+
+	return
+}
+
+func (payload *ListStockReorderPointPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *ListStockAlertPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.StockAlertStatusOpen, constants.StockAlertStatusAcknowledged, constants.StockAlertStatusResolved:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *UpsertStockReorderPointPayload) ToEntity(userGUID string) (data sqlc.UpsertStockReorderPointParams) {
+	data = sqlc.UpsertStockReorderPointParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   payload.ProductID,
+		WarehouseGuid: payload.WarehouseID,
+		MinQuantity:   payload.MinQuantity,
+		MaxQuantity:   payload.MaxQuantity,
+		ReorderPoint:  payload.ReorderPoint,
+		CreatedBy:     userGUID,
+	}
+
+	return
+}
+
+func (payload *ListStockReorderPointPayload) ToEntity() (data sqlc.ListStockReorderPointParams) {
+	data = sqlc.ListStockReorderPointParams{
+		SetWarehouse:      payload.Filter.SetWarehouse,
+		WarehouseGuid:     payload.Filter.WarehouseID,
+		SetProduct:        payload.Filter.SetProduct,
+		ProductGuid:       payload.Filter.ProductID,
+		BelowReorderPoint: payload.Filter.BelowReorderPoint,
+		OffsetPage:        (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:         payload.Limit,
+	}
+
+	return
+}
+
+func (payload *ListStockAlertPayload) ToEntity() (data sqlc.ListStockAlertParams) {
+	data = sqlc.ListStockAlertParams{
+		SetStatus:     payload.Filter.SetStatus,
+		Status:        payload.Filter.Status,
+		SetWarehouse:  payload.Filter.SetWarehouse,
+		WarehouseGuid: payload.Filter.WarehouseID,
+		SetProduct:    payload.Filter.SetProduct,
+		ProductGuid:   payload.Filter.ProductID,
+		OffsetPage:    (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:     payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadStockReorderPoint(reorderPointData sqlc.GetStockReorderPointRow) (payload readStockReorderPointPayload) {
+	payload = readStockReorderPointPayload{
+		GUID:           reorderPointData.Guid,
+		ProductID:      reorderPointData.ProductGuid,
+		ProductName:    reorderPointData.ProductName.String,
+		WarehouseID:    reorderPointData.WarehouseGuid,
+		WarehouseCode:  reorderPointData.WarehouseCode.String,
+		WarehouseName:  reorderPointData.WarehouseName.String,
+		MinQuantity:    reorderPointData.MinQuantity,
+		MaxQuantity:    reorderPointData.MaxQuantity,
+		ReorderPoint:   reorderPointData.ReorderPoint,
+		OnHandQuantity: reorderPointData.OnHandQuantity,
+		CreatedAt:      reorderPointData.CreatedAt,
+		CreatedBy:      reorderPointData.CreatedBy,
+	}
+
+	if reorderPointData.UpdatedAt.Valid {
+		payload.UpdatedAt = &reorderPointData.UpdatedAt.Time
+		payload.UpdatedBy = &reorderPointData.UpdatedBy.String
+	}
+
+	return
+}
+
+func ToPayloadListStockReorderPoint(listReorderPoint []sqlc.ListStockReorderPointRow) (payload []*readStockReorderPointPayload) {
+	payload = make([]*readStockReorderPointPayload, len(listReorderPoint))
+
+	for i := range listReorderPoint {
+		payload[i] = new(readStockReorderPointPayload)
+		data := ToPayloadStockReorderPoint(sqlc.GetStockReorderPointRow(listReorderPoint[i]))
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadStockAlert(alertData sqlc.GetStockAlertRow) (payload readStockAlertPayload) {
+	payload = readStockAlertPayload{
+		GUID:              alertData.Guid,
+		ProductID:         alertData.ProductGuid,
+		ProductName:       alertData.ProductName.String,
+		WarehouseID:       alertData.WarehouseGuid,
+		WarehouseCode:     alertData.WarehouseCode.String,
+		WarehouseName:     alertData.WarehouseName.String,
+		AlertType:         alertData.AlertType,
+		OnHandQuantity:    alertData.OnHandQuantity,
+		ReorderPoint:      alertData.ReorderPoint,
+		SuggestedQuantity: alertData.SuggestedQuantity,
+		Status:            alertData.Status,
+		CreatedAt:         alertData.CreatedAt,
+	}
+
+	if alertData.NotifiedAt.Valid {
+		payload.NotifiedAt = &alertData.NotifiedAt.Time
+	}
+
+	if alertData.AcknowledgedAt.Valid {
+		payload.AcknowledgedAt = &alertData.AcknowledgedAt.Time
+		payload.AcknowledgedBy = &alertData.AcknowledgedBy.String
+	}
+
+	if alertData.ResolvedAt.Valid {
+		payload.ResolvedAt = &alertData.ResolvedAt.Time
+	}
+
+	return
+}
+
+func ToPayloadListStockAlert(listAlert []sqlc.ListStockAlertRow) (payload []*readStockAlertPayload) {
+	payload = make([]*readStockAlertPayload, len(listAlert))
+
+	for i := range listAlert {
+		payload[i] = new(readStockAlertPayload)
+		data := ToPayloadStockAlert(sqlc.GetStockAlertRow(listAlert[i]))
+		payload[i] = &data
+	}
+
+	return
+}
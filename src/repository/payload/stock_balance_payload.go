@@ -0,0 +1,170 @@
+package payload
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type ListStockBalancePayload struct {
+	Limit  int32 `query:"limit"`
+	Offset int32 `query:"page"`
+	// AsOf rebuilds the warehouse stock at a date (2006-01-02, end of day) or an RFC 3339 time
+	AsOf string `query:"as_of"`
+
+	asOf time.Time
+}
+
+type readStockBalanceAsOfPayload struct {
+	ProductID   string    `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	WarehouseID string    `json:"warehouse_id"`
+	Quantity    int64     `json:"quantity"`
+	AsOf        time.Time `json:"as_of"`
+}
+
+type readStockBalancePayload struct {
+	ProductID          string     `json:"product_id"`
+	ProductName        string     `json:"product_name"`
+	WarehouseID        string     `json:"warehouse_id"`
+	WarehouseCode      string     `json:"warehouse_code"`
+	WarehouseName      string     `json:"warehouse_name"`
+	Quantity           int64      `json:"quantity"`
+	QuarantineQuantity int64      `json:"quarantine_quantity"`
+	DamagedQuantity    int64      `json:"damaged_quantity"`
+	OnHoldQuantity     int64      `json:"on_hold_quantity"`
+	ReservedQuantity   int64      `json:"reserved_quantity"`
+	AvailableQuantity  int64      `json:"available_quantity"` // on-hand minus held and reserved, never below zero
+	UpdatedAt          *time.Time `json:"updated_at"`
+}
+
+func (payload *ListStockBalancePayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Limit == 0 {
+		payload.Limit = 10
+	}
+
+	if payload.Offset == 0 {
+		payload.Offset = 1
+	}
+
+	if payload.Limit < 1 || payload.Offset < 1 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: limit and page must be at least 1")
+		return
+	}
+
+	if payload.AsOf != "" {
+		if payload.asOf, err = parseAsOf(payload.AsOf); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListStockBalancePayload) ToEntityWarehouse(warehouseGUID string) (data sqlc.ListStockBalanceByWarehouseParams) {
+	data = sqlc.ListStockBalanceByWarehouseParams{
+		WarehouseGuid: warehouseGUID,
+		OffsetPage:    (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:     payload.Limit,
+	}
+
+	return
+}
+
+// ToEntityWarehouseAsOf leaves the snapshot to start from to the service.
+func (payload *ListStockBalancePayload) ToEntityWarehouseAsOf(warehouseGUID string) (data sqlc.ListStockBalanceAsOfParams) {
+	data = sqlc.ListStockBalanceAsOfParams{
+		WarehouseGuid: warehouseGUID,
+		AsOf:          payload.asOf,
+		OffsetPage:    (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:     payload.Limit,
+	}
+
+	return
+}
+
+func (payload *ListStockBalancePayload) ToEntityProduct(productGUID string) (data sqlc.ListStockBalanceByProductParams) {
+	data = sqlc.ListStockBalanceByProductParams{
+		ProductGuid: productGUID,
+		OffsetPage:  (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:   payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadStockBalance(stockBalanceData sqlc.ListStockBalanceByWarehouseRow) (payload readStockBalancePayload) {
+	payload = readStockBalancePayload{
+		ProductID:          stockBalanceData.ProductGuid,
+		ProductName:        stockBalanceData.ProductName.String,
+		WarehouseID:        stockBalanceData.WarehouseGuid,
+		WarehouseCode:      stockBalanceData.WarehouseCode.String,
+		WarehouseName:      stockBalanceData.WarehouseName.String,
+		Quantity:           stockBalanceData.Quantity,
+		QuarantineQuantity: stockBalanceData.QuarantineQuantity,
+		DamagedQuantity:    stockBalanceData.DamagedQuantity,
+		OnHoldQuantity:     stockBalanceData.OnHoldQuantity,
+		ReservedQuantity:   stockBalanceData.ReservedQuantity,
+	}
+
+	held := stockBalanceData.QuarantineQuantity + stockBalanceData.DamagedQuantity + stockBalanceData.OnHoldQuantity
+	if available := stockBalanceData.Quantity - held - stockBalanceData.ReservedQuantity; available > 0 {
+		payload.AvailableQuantity = available
+	}
+
+	if stockBalanceData.UpdatedAt.Valid {
+		payload.UpdatedAt = &stockBalanceData.UpdatedAt.Time
+	} else {
+		payload.UpdatedAt = &stockBalanceData.CreatedAt
+	}
+
+	return
+}
+
+func ToPayloadListWarehouseStockBalance(listStockBalance []sqlc.ListStockBalanceByWarehouseRow) (payload []*readStockBalancePayload) {
+	payload = make([]*readStockBalancePayload, len(listStockBalance))
+
+	for i := range listStockBalance {
+		payload[i] = new(readStockBalancePayload)
+		data := ToPayloadStockBalance(listStockBalance[i])
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadListProductStockBalance(listStockBalance []sqlc.ListStockBalanceByProductRow) (payload []*readStockBalancePayload) {
+	payload = make([]*readStockBalancePayload, len(listStockBalance))
+
+	for i := range listStockBalance {
+		payload[i] = new(readStockBalancePayload)
+		data := ToPayloadStockBalance(sqlc.ListStockBalanceByWarehouseRow(listStockBalance[i]))
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadListWarehouseStockBalanceAsOf(warehouseGUID string, asOf time.Time, listStockBalance []sqlc.ListStockBalanceAsOfRow) (payload []*readStockBalanceAsOfPayload) {
+	payload = make([]*readStockBalanceAsOfPayload, len(listStockBalance))
+
+	for i := range listStockBalance {
+		payload[i] = &readStockBalanceAsOfPayload{
+			ProductID:   listStockBalance[i].ProductGuid,
+			ProductName: listStockBalance[i].ProductName.String,
+			WarehouseID: warehouseGUID,
+			Quantity:    listStockBalance[i].Quantity,
+			AsOf:        asOf,
+		}
+	}
+
+	return
+}
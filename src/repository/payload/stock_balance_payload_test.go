@@ -0,0 +1,77 @@
+package payload
+
+import (
+	"testing"
+)
+
+func TestListStockBalancePayload_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		payload    ListStockBalancePayload
+		wantLimit  int32
+		wantOffset int32
+		wantErr    bool
+	}{
+		{
+			name:       "defaults an omitted limit and page",
+			wantLimit:  10,
+			wantOffset: 1,
+		},
+		{
+			name:       "keeps the given limit and page",
+			payload:    ListStockBalancePayload{Limit: 25, Offset: 3},
+			wantLimit:  25,
+			wantOffset: 3,
+		},
+		{
+			name:    "rejects a negative limit",
+			payload: ListStockBalancePayload{Limit: -10, Offset: 1},
+			wantErr: true,
+		},
+		{
+			name:    "rejects a negative page",
+			payload: ListStockBalancePayload{Limit: 10, Offset: -1},
+			wantErr: true,
+		},
+		{
+			name:       "pages a stock query as of a date",
+			payload:    ListStockBalancePayload{Limit: 5, Offset: 2, AsOf: "2026-03-31"},
+			wantLimit:  5,
+			wantOffset: 2,
+		},
+		{
+			name:    "rejects a negative limit on a stock query as of a date",
+			payload: ListStockBalancePayload{Limit: -5, Offset: 1, AsOf: "2026-03-31"},
+			wantErr: true,
+		},
+		{
+			name:    "rejects a negative page on a stock query as of a date",
+			payload: ListStockBalancePayload{Limit: 5, Offset: -2, AsOf: "2026-03-31"},
+			wantErr: true,
+		},
+		{
+			name:    "rejects an as-of date it cannot read",
+			payload: ListStockBalancePayload{AsOf: "31/03/2026"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && (tt.payload.Limit != tt.wantLimit || tt.payload.Offset != tt.wantOffset) {
+				t.Errorf("Validate() limit, page = %v, %v, want %v, %v", tt.payload.Limit, tt.payload.Offset, tt.wantLimit, tt.wantOffset)
+			}
+
+			// The as-of query must never be sent a negative offset
+			if data := tt.payload.ToEntityWarehouseAsOf("warehouse-1"); !tt.wantErr && data.OffsetPage != (tt.wantOffset-1)*tt.wantLimit {
+				t.Errorf("ToEntityWarehouseAsOf() offset = %v, want %v", data.OffsetPage, (tt.wantOffset-1)*tt.wantLimit)
+			}
+		})
+	}
+}
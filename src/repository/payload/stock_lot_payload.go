@@ -0,0 +1,160 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type SuggestStockLotPayload struct {
+	ProductID string `query:"product_id" valid:"required"`
+	Quantity  int64  `query:"quantity" valid:"required"`
+}
+
+type ListExpiringStockLotPayload struct {
+	Days   int32 `query:"days"`
+	Limit  int32 `query:"limit"`
+	Offset int32 `query:"page"`
+}
+
+type readStockLotSuggestionPayload struct {
+	ProductID           string                     `json:"product_id"`
+	WarehouseID         string                     `json:"warehouse_id"`
+	Quantity            int64                      `json:"quantity"`
+	UnallocatedQuantity int64                      `json:"unallocated_quantity"`
+	Lots                []*readStockLotPickPayload `json:"lots"`
+}
+
+type readStockLotPickPayload struct {
+	LotNumber         string     `json:"lot_number"`
+	ExpiryDate        *time.Time `json:"expiry_date"`
+	AvailableQuantity int64      `json:"available_quantity"`
+	PickQuantity      int64      `json:"pick_quantity"`
+}
+
+type readExpiringStockLotPayload struct {
+	ProductID    string     `json:"product_id"`
+	ProductName  string     `json:"product_name"`
+	WarehouseID  string     `json:"warehouse_id"`
+	LotNumber    string     `json:"lot_number"`
+	ExpiryDate   *time.Time `json:"expiry_date"`
+	DaysToExpiry int        `json:"days_to_expiry"`
+	Quantity     int64      `json:"quantity"`
+}
+
+func (payload *SuggestStockLotPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Quantity <= 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+		return
+	}
+
+	return
+}
+
+func (payload *ListExpiringStockLotPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Days < 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: days must not be negative")
+		return
+	}
+
+	if payload.Days == 0 {
+		payload.Days = 30
+	}
+
+	if payload.Limit == 0 {
+		payload.Limit = 10
+	}
+
+	if payload.Offset == 0 {
+		payload.Offset = 1
+	}
+
+	return
+}
+
+func (payload *SuggestStockLotPayload) ToEntity(warehouseGUID string) (data sqlc.ListStockLotBalanceFEFOParams) {
+	data = sqlc.ListStockLotBalanceFEFOParams{
+		ProductGuid:   payload.ProductID,
+		WarehouseGuid: warehouseGUID,
+	}
+
+	return
+}
+
+func (payload *ListExpiringStockLotPayload) ToEntity(warehouseGUID string) (data sqlc.ListExpiringStockLotParams) {
+	data = sqlc.ListExpiringStockLotParams{
+		WarehouseGuid: warehouseGUID,
+		ExpiryBefore: sql.NullTime{
+			Time:  time.Now().UTC().AddDate(0, 0, int(payload.Days)),
+			Valid: true,
+		},
+		OffsetPage: (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:  payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadStockLotSuggestion(request SuggestStockLotPayload, warehouseGUID string, listLot []sqlc.StockLotBalance, allocation []sqlc.StockLotBalance) (payload readStockLotSuggestionPayload) {
+	payload = readStockLotSuggestionPayload{
+		ProductID:           request.ProductID,
+		WarehouseID:         warehouseGUID,
+		Quantity:            request.Quantity,
+		UnallocatedQuantity: request.Quantity,
+		Lots:                make([]*readStockLotPickPayload, len(allocation)),
+	}
+
+	// Allocation follows the FEFO order of listLot, so the indexes line up
+	for i := range allocation {
+		payload.Lots[i] = &readStockLotPickPayload{
+			LotNumber:         allocation[i].LotNumber,
+			AvailableQuantity: listLot[i].Quantity,
+			PickQuantity:      allocation[i].Quantity,
+		}
+
+		if allocation[i].ExpiryDate.Valid {
+			payload.Lots[i].ExpiryDate = &allocation[i].ExpiryDate.Time
+		}
+
+		payload.UnallocatedQuantity -= allocation[i].Quantity
+	}
+
+	return
+}
+
+func ToPayloadListExpiringStockLot(listStockLot []sqlc.ListExpiringStockLotRow) (payload []*readExpiringStockLotPayload) {
+	payload = make([]*readExpiringStockLotPayload, len(listStockLot))
+	now := time.Now().UTC()
+
+	for i := range listStockLot {
+		payload[i] = &readExpiringStockLotPayload{
+			ProductID:   listStockLot[i].ProductGuid,
+			ProductName: listStockLot[i].ProductName.String,
+			WarehouseID: listStockLot[i].WarehouseGuid,
+			LotNumber:   listStockLot[i].LotNumber,
+			Quantity:    listStockLot[i].Quantity,
+		}
+
+		if listStockLot[i].ExpiryDate.Valid {
+			payload[i].ExpiryDate = &listStockLot[i].ExpiryDate.Time
+			payload[i].DaysToExpiry = int(listStockLot[i].ExpiryDate.Time.Sub(now).Hours() / 24)
+		}
+	}
+
+	return
+}
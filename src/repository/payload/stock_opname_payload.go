@@ -0,0 +1,275 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type InsertStockOpnamePayload struct {
+	WarehouseID string `json:"warehouse_id" valid:"required"`
+	Notes       string `json:"notes"`
+}
+
+type CountStockOpnamePayload struct {
+	Items []CountStockOpnameItemPayload `json:"items" valid:"required"`
+}
+
+type CountStockOpnameItemPayload struct {
+	ProductID       string `json:"product_id" valid:"required"`
+	CountedQuantity int64  `json:"counted_quantity"`
+}
+
+type ListStockOpnamePayload struct {
+	Filter ListStockOpnameFilterPayload `json:"filter"`
+	Limit  int32                        `json:"limit" valid:"required"`
+	Offset int32                        `json:"page" valid:"required"`
+	Order  string                       `json:"order" valid:"required"`
+	Sort   string                       `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListStockOpnameFilterPayload struct {
+	SetOpnameCode bool   `json:"set_opname_code"`
+	OpnameCode    string `json:"opname_code"`
+	SetStatus     bool   `json:"set_status"`
+	Status        string `json:"status"` // open, approved, cancelled
+	SetWarehouse  bool   `json:"set_warehouse"`
+	WarehouseID   string `json:"warehouse_id"`
+}
+
+type readStockOpnamePayload struct {
+	GUID          string                        `json:"id"`
+	OpnameCode    string                        `json:"opname_code"`
+	WarehouseID   string                        `json:"warehouse_id"`
+	WarehouseCode string                        `json:"warehouse_code"`
+	WarehouseName string                        `json:"warehouse_name"`
+	Status        string                        `json:"status"`
+	Notes         string                        `json:"notes"`
+	Items         []*readStockOpnameItemPayload `json:"items,omitempty"`
+	ApprovedAt    *time.Time                    `json:"approved_at"`
+	ApprovedBy    *string                       `json:"approved_by"`
+	CancelledAt   *time.Time                    `json:"cancelled_at"`
+	CancelledBy   *string                       `json:"cancelled_by"`
+	CreatedAt     time.Time                     `json:"created_at"`
+	CreatedBy     string                        `json:"created_by"`
+}
+
+type readStockOpnameItemPayload struct {
+	GUID             string    `json:"id"`
+	ProductID        string    `json:"product_id"`
+	ProductName      string    `json:"product_name"`
+	ExpectedQuantity int64     `json:"expected_quantity"`
+	CountedQuantity  int64     `json:"counted_quantity"`
+	Variance         int64     `json:"variance"`
+	CountedAt        time.Time `json:"counted_at"`
+	CountedBy        string    `json:"counted_by"`
+}
+
+type readStockOpnameReportPayload struct {
+	readStockOpnamePayload
+	TotalItem         int   `json:"total_item"`
+	TotalItemVariance int   `json:"total_item_variance"`
+	TotalExpected     int64 `json:"total_expected"`
+	TotalCounted      int64 `json:"total_counted"`
+	TotalVariance     int64 `json:"total_variance"`
+}
+
+func (payload *InsertStockOpnamePayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *CountStockOpnamePayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if len(payload.Items) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: items is required")
+		return
+	}
+
+	for _, item := range payload.Items {
+		if item.CountedQuantity < 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: counted quantity must not be negative")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListStockOpnamePayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.StockOpnameStatusOpen, constants.StockOpnameStatusApproved, constants.StockOpnameStatusCancelled:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *InsertStockOpnamePayload) ToEntity(userGUID string) (data sqlc.InsertStockOpnameParams) {
+	data = sqlc.InsertStockOpnameParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		OpnameCode:    utility.GenerateDocumentNumber(constants.StockOpnameCodePrefix),
+		WarehouseGuid: payload.WarehouseID,
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+	}
+
+	return
+}
+
+func (payload *CountStockOpnamePayload) ToEntity(stockOpnameGUID string, userGUID string) (data []sqlc.UpsertStockOpnameItemParams) {
+	data = make([]sqlc.UpsertStockOpnameItemParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[i] = sqlc.UpsertStockOpnameItemParams{
+			Guid:            utility.GenerateGoogleUUID(),
+			StockOpnameGuid: stockOpnameGUID,
+			ProductGuid:     payload.Items[i].ProductID,
+			CountedQuantity: payload.Items[i].CountedQuantity,
+			CountedBy:       userGUID,
+		}
+	}
+
+	return
+}
+
+func (payload *ListStockOpnamePayload) ToEntity() (data sqlc.ListStockOpnameParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListStockOpnameParams{
+		SetOpnameCode: payload.Filter.SetOpnameCode,
+		OpnameCode:    "%" + payload.Filter.OpnameCode + "%",
+		SetStatus:     payload.Filter.SetStatus,
+		Status:        payload.Filter.Status,
+		SetWarehouse:  payload.Filter.SetWarehouse,
+		WarehouseGuid: payload.Filter.WarehouseID,
+		LimitData:     payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadStockOpname(stockOpnameData sqlc.GetStockOpnameRow, listItem []sqlc.ListStockOpnameItemRow) (payload readStockOpnamePayload) {
+	payload = readStockOpnamePayload{
+		GUID:          stockOpnameData.Guid,
+		OpnameCode:    stockOpnameData.OpnameCode,
+		WarehouseID:   stockOpnameData.WarehouseGuid,
+		WarehouseCode: stockOpnameData.WarehouseCode.String,
+		WarehouseName: stockOpnameData.WarehouseName.String,
+		Status:        stockOpnameData.Status,
+		Notes:         stockOpnameData.Notes.String,
+		CreatedAt:     stockOpnameData.CreatedAt,
+		CreatedBy:     stockOpnameData.CreatedBy,
+	}
+
+	if stockOpnameData.ApprovedAt.Valid {
+		payload.ApprovedAt = &stockOpnameData.ApprovedAt.Time
+		payload.ApprovedBy = &stockOpnameData.ApprovedBy.String
+	}
+
+	if stockOpnameData.CancelledAt.Valid {
+		payload.CancelledAt = &stockOpnameData.CancelledAt.Time
+		payload.CancelledBy = &stockOpnameData.CancelledBy.String
+	}
+
+	if listItem != nil {
+		payload.Items = make([]*readStockOpnameItemPayload, len(listItem))
+
+		for i := range listItem {
+			// Counts carry the stock at count time
+			expected := listItem[i].ExpectedQuantity.Int64
+
+			payload.Items[i] = &readStockOpnameItemPayload{
+				GUID:             listItem[i].Guid,
+				ProductID:        listItem[i].ProductGuid,
+				ProductName:      listItem[i].ProductName.String,
+				ExpectedQuantity: expected,
+				CountedQuantity:  listItem[i].CountedQuantity,
+				Variance:         listItem[i].CountedQuantity - expected,
+				CountedAt:        listItem[i].CountedAt,
+				CountedBy:        listItem[i].CountedBy,
+			}
+		}
+	}
+
+	return
+}
+
+func ToPayloadStockOpnameReport(stockOpnameData sqlc.GetStockOpnameRow, listItem []sqlc.ListStockOpnameItemRow) (payload readStockOpnameReportPayload) {
+	payload = readStockOpnameReportPayload{
+		readStockOpnamePayload: ToPayloadStockOpname(stockOpnameData, listItem),
+		TotalItem:              len(listItem),
+	}
+
+	for _, item := range payload.Items {
+		payload.TotalExpected += item.ExpectedQuantity
+		payload.TotalCounted += item.CountedQuantity
+		payload.TotalVariance += item.Variance
+
+		if item.Variance != 0 {
+			payload.TotalItemVariance++
+		}
+	}
+
+	return
+}
+
+func ToPayloadListStockOpname(listStockOpname []sqlc.ListStockOpnameRow) (payload []*readStockOpnamePayload) {
+	payload = make([]*readStockOpnamePayload, len(listStockOpname))
+
+	for i := range listStockOpname {
+		payload[i] = new(readStockOpnamePayload)
+		data := ToPayloadStockOpname(sqlc.GetStockOpnameRow(listStockOpname[i]), nil)
+		payload[i] = &data
+	}
+
+	return
+}
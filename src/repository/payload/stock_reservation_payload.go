@@ -0,0 +1,114 @@
+package payload
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type ListStockReservationPayload struct {
+	Filter ListStockReservationFilterPayload `json:"filter"`
+	Limit  int32                             `json:"limit" valid:"required"`
+	Offset int32                             `json:"page" valid:"required"`
+}
+
+type ListStockReservationFilterPayload struct {
+	SetWarehouse bool   `json:"set_warehouse"`
+	WarehouseID  string `json:"warehouse_id"`
+	SetProduct   bool   `json:"set_product"`
+	ProductID    string `json:"product_id"`
+	SetStatus    bool   `json:"set_status"`
+	Status       string `json:"status"` // active, fulfilled, released, expired
+	SetReference bool   `json:"set_reference"`
+	ReferenceID  string `json:"reference_id"`
+}
+
+type readStockReservationPayload struct {
+	GUID            string     `json:"id"`
+	ProductID       string     `json:"product_id"`
+	ProductName     string     `json:"product_name"`
+	WarehouseID     string     `json:"warehouse_id"`
+	WarehouseCode   string     `json:"warehouse_code"`
+	WarehouseName   string     `json:"warehouse_name"`
+	Quantity        int64      `json:"quantity"`
+	ReferenceType   string     `json:"reference_type"`
+	ReferenceID     string     `json:"reference_id"`
+	ReferenceItemID string     `json:"reference_item_id"`
+	Status          string     `json:"status"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CreatedBy       string     `json:"created_by"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+func (payload *ListStockReservationPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.StockReservationStatusActive, constants.StockReservationStatusFulfilled,
+			constants.StockReservationStatusReleased, constants.StockReservationStatusExpired:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListStockReservationPayload) ToEntity() (data sqlc.ListStockReservationParams) {
+	data = sqlc.ListStockReservationParams{
+		SetWarehouse:  payload.Filter.SetWarehouse,
+		WarehouseGuid: payload.Filter.WarehouseID,
+		SetProduct:    payload.Filter.SetProduct,
+		ProductGuid:   payload.Filter.ProductID,
+		SetStatus:     payload.Filter.SetStatus,
+		Status:        payload.Filter.Status,
+		SetReference:  payload.Filter.SetReference,
+		ReferenceGuid: payload.Filter.ReferenceID,
+		OffsetPage:    (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:     payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadListStockReservation(listReservation []sqlc.ListStockReservationRow) (payload []*readStockReservationPayload) {
+	payload = make([]*readStockReservationPayload, len(listReservation))
+
+	for i := range listReservation {
+		payload[i] = &readStockReservationPayload{
+			GUID:            listReservation[i].Guid,
+			ProductID:       listReservation[i].ProductGuid,
+			ProductName:     listReservation[i].ProductName.String,
+			WarehouseID:     listReservation[i].WarehouseGuid,
+			WarehouseCode:   listReservation[i].WarehouseCode.String,
+			WarehouseName:   listReservation[i].WarehouseName.String,
+			Quantity:        listReservation[i].Quantity,
+			ReferenceType:   listReservation[i].ReferenceType,
+			ReferenceID:     listReservation[i].ReferenceGuid,
+			ReferenceItemID: listReservation[i].ReferenceItemGuid,
+			Status:          listReservation[i].Status,
+			CreatedAt:       listReservation[i].CreatedAt,
+			CreatedBy:       listReservation[i].CreatedBy,
+		}
+
+		if listReservation[i].ExpiresAt.Valid {
+			payload[i].ExpiresAt = &listReservation[i].ExpiresAt.Time
+		}
+
+		if listReservation[i].UpdatedAt.Valid {
+			payload[i].UpdatedAt = &listReservation[i].UpdatedAt.Time
+		}
+	}
+
+	return
+}
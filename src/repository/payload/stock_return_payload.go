@@ -0,0 +1,375 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type InsertStockReturnPayload struct {
+	// ProductHistoryID is the outbound movement the goods are returned against
+	ProductHistoryID string `json:"product_history_id" valid:"required"`
+	Quantity         int64  `json:"quantity" valid:"required"`
+	ReasonCode       string `json:"reason_code" valid:"required"` // damaged, defective, wrong_item, not_as_described, no_longer_needed, other
+	CustomerID       string `json:"customer_id"`                  // empty uses the customer of the movement
+	Notes            string `json:"notes"`
+}
+
+type InspectStockReturnPayload struct {
+	Outcomes []InspectStockReturnOutcomePayload `json:"outcomes" valid:"required"`
+}
+
+// InspectStockReturnOutcomePayload sets the disposition of part of the returned quantity,
+// the outcomes together must account for the whole return.
+type InspectStockReturnOutcomePayload struct {
+	Disposition string `json:"disposition" valid:"required"` // restock, quarantine, scrap
+	Quantity    int64  `json:"quantity" valid:"required"`
+	ReasonCode  string `json:"reason_code"` // write-off reason, required to scrap
+	BinID       string `json:"bin_id"`      // restock and quarantine only
+	Notes       string `json:"notes"`
+	// SerialNumbers lists the returned serials for serialized products
+	SerialNumbers []string `json:"serial_numbers"`
+}
+
+type ListStockReturnPayload struct {
+	Filter ListStockReturnFilterPayload `json:"filter"`
+	Limit  int32                        `json:"limit" valid:"required"`
+	Offset int32                        `json:"page" valid:"required"`
+	Order  string                       `json:"order" valid:"required"`
+	Sort   string                       `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListStockReturnFilterPayload struct {
+	SetReturnNumber bool   `json:"set_return_number"`
+	ReturnNumber    string `json:"return_number"`
+	SetStatus       bool   `json:"set_status"`
+	Status          string `json:"status"` // requested, received, inspected, cancelled
+	SetCustomer     bool   `json:"set_customer"`
+	CustomerID      string `json:"customer_id"`
+	SetWarehouse    bool   `json:"set_warehouse"`
+	WarehouseID     string `json:"warehouse_id"`
+}
+
+type readStockReturnPayload struct {
+	GUID             string                           `json:"id"`
+	ReturnNumber     string                           `json:"return_number"`
+	ProductHistoryID string                           `json:"product_history_id"`
+	ProductID        string                           `json:"product_id"`
+	ProductName      string                           `json:"product_name"`
+	WarehouseID      string                           `json:"warehouse_id"`
+	WarehouseCode    string                           `json:"warehouse_code"`
+	WarehouseName    string                           `json:"warehouse_name"`
+	CustomerID       *string                          `json:"customer_id"`
+	CustomerCode     *string                          `json:"customer_code"`
+	CustomerName     *string                          `json:"customer_name"`
+	Quantity         int64                            `json:"quantity"`
+	ReasonCode       string                           `json:"reason_code"`
+	Status           string                           `json:"status"`
+	Notes            string                           `json:"notes"`
+	Outcomes         []*readStockReturnOutcomePayload `json:"outcomes,omitempty"`
+	ReceivedAt       *time.Time                       `json:"received_at"`
+	ReceivedBy       *string                          `json:"received_by"`
+	InspectedAt      *time.Time                       `json:"inspected_at"`
+	InspectedBy      *string                          `json:"inspected_by"`
+	CancelledAt      *time.Time                       `json:"cancelled_at"`
+	CancelledBy      *string                          `json:"cancelled_by"`
+	CreatedAt        time.Time                        `json:"created_at"`
+	CreatedBy        string                           `json:"created_by"`
+	UpdatedAt        *time.Time                       `json:"updated_at"`
+	UpdatedBy        *string                          `json:"updated_by"`
+}
+
+type readStockReturnOutcomePayload struct {
+	GUID             string    `json:"id"`
+	Disposition      string    `json:"disposition"`
+	Quantity         int64     `json:"quantity"`
+	ReasonCode       *string   `json:"reason_code"`
+	BinID            *string   `json:"bin_id"`
+	ProductHistoryID *string   `json:"product_history_id"` // the restock, quarantine or write-off movement
+	Notes            *string   `json:"notes"`
+	CreatedAt        time.Time `json:"created_at"`
+	CreatedBy        string    `json:"created_by"`
+}
+
+func (payload *InsertStockReturnPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Quantity <= 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+		return
+	}
+
+	switch payload.ReasonCode {
+	case constants.StockReturnReasonDamaged, constants.StockReturnReasonDefective, constants.StockReturnReasonWrongItem,
+		constants.StockReturnReasonNotAsDescribed, constants.StockReturnReasonNoLongerNeeded, constants.StockReturnReasonOther:
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid reason code")
+		return
+	}
+
+	return
+}
+
+func (payload *InspectStockReturnPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if len(payload.Outcomes) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: outcomes is required")
+		return
+	}
+
+	for _, outcome := range payload.Outcomes {
+		if outcome.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+
+		switch outcome.Disposition {
+		case constants.StockReturnDispositionRestock, constants.StockReturnDispositionQuarantine:
+		case constants.StockReturnDispositionScrap:
+			if outcome.BinID != "" {
+				err = errors.Wrap(httpservice.ErrBadRequest, "bad request: bin is not used to scrap")
+				return
+			}
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid disposition")
+			return
+		}
+
+		if outcome.Disposition != constants.StockReturnDispositionScrap {
+			if outcome.ReasonCode != "" {
+				err = errors.Wrap(httpservice.ErrBadRequest, "bad request: reason code is only used to scrap")
+				return
+			}
+
+			continue
+		}
+
+		switch outcome.ReasonCode {
+		case constants.StockWriteOffReasonDamaged, constants.StockWriteOffReasonDefective, constants.StockWriteOffReasonExpired,
+			constants.StockWriteOffReasonContaminated, constants.StockWriteOffReasonOther:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid write-off reason code")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListStockReturnPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.StockReturnStatusRequested, constants.StockReturnStatusReceived,
+			constants.StockReturnStatusInspected, constants.StockReturnStatusCancelled:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *InsertStockReturnPayload) ToEntity(userGUID string) (data sqlc.InsertStockReturnParams) {
+	data = sqlc.InsertStockReturnParams{
+		Guid:                utility.GenerateGoogleUUID(),
+		ReturnNumber:        utility.GenerateDocumentNumber(constants.StockReturnCodePrefix),
+		ProductsHistoryGuid: payload.ProductHistoryID,
+		CustomerGuid: sql.NullString{
+			String: payload.CustomerID,
+			Valid:  payload.CustomerID != "",
+		},
+		Quantity:   payload.Quantity,
+		ReasonCode: payload.ReasonCode,
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+	}
+
+	return
+}
+
+// ToEntity returns the outcomes to record and the serials returned with each of them, by
+// position.
+func (payload *InspectStockReturnPayload) ToEntity(stockReturnGUID string, userGUID string) (data []sqlc.InsertStockReturnOutcomeParams, serials [][]string) {
+	data = make([]sqlc.InsertStockReturnOutcomeParams, len(payload.Outcomes))
+	serials = make([][]string, len(payload.Outcomes))
+
+	for i, outcome := range payload.Outcomes {
+		data[i] = sqlc.InsertStockReturnOutcomeParams{
+			Guid:            utility.GenerateGoogleUUID(),
+			StockReturnGuid: stockReturnGUID,
+			Disposition:     outcome.Disposition,
+			Quantity:        outcome.Quantity,
+			ReasonCode: sql.NullString{
+				String: outcome.ReasonCode,
+				Valid:  outcome.ReasonCode != "",
+			},
+			BinGuid: sql.NullString{
+				String: outcome.BinID,
+				Valid:  outcome.BinID != "",
+			},
+			Notes: sql.NullString{
+				String: outcome.Notes,
+				Valid:  outcome.Notes != "",
+			},
+			CreatedBy: userGUID,
+		}
+
+		serials[i] = outcome.SerialNumbers
+	}
+
+	return
+}
+
+func (payload *ListStockReturnPayload) ToEntity() (data sqlc.ListStockReturnParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListStockReturnParams{
+		SetReturnNumber: payload.Filter.SetReturnNumber,
+		ReturnNumber:    "%" + payload.Filter.ReturnNumber + "%",
+		SetStatus:       payload.Filter.SetStatus,
+		Status:          payload.Filter.Status,
+		SetCustomer:     payload.Filter.SetCustomer,
+		CustomerGuid: sql.NullString{
+			String: payload.Filter.CustomerID,
+			Valid:  true,
+		},
+		SetWarehouse:  payload.Filter.SetWarehouse,
+		WarehouseGuid: payload.Filter.WarehouseID,
+		LimitData:     payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadStockReturn(stockReturnData sqlc.GetStockReturnRow, listOutcome []sqlc.StockReturnOutcome) (payload readStockReturnPayload) {
+	payload = readStockReturnPayload{
+		GUID:             stockReturnData.Guid,
+		ReturnNumber:     stockReturnData.ReturnNumber,
+		ProductHistoryID: stockReturnData.ProductsHistoryGuid,
+		ProductID:        stockReturnData.ProductGuid,
+		ProductName:      stockReturnData.ProductName.String,
+		WarehouseID:      stockReturnData.WarehouseGuid,
+		WarehouseCode:    stockReturnData.WarehouseCode.String,
+		WarehouseName:    stockReturnData.WarehouseName.String,
+		Quantity:         stockReturnData.Quantity,
+		ReasonCode:       stockReturnData.ReasonCode,
+		Status:           stockReturnData.Status,
+		Notes:            stockReturnData.Notes.String,
+		CreatedAt:        stockReturnData.CreatedAt,
+		CreatedBy:        stockReturnData.CreatedBy,
+	}
+
+	if stockReturnData.CustomerGuid.Valid {
+		payload.CustomerID = &stockReturnData.CustomerGuid.String
+		payload.CustomerCode = &stockReturnData.CustomerCode.String
+		payload.CustomerName = &stockReturnData.CustomerName.String
+	}
+
+	if stockReturnData.ReceivedAt.Valid {
+		payload.ReceivedAt = &stockReturnData.ReceivedAt.Time
+		payload.ReceivedBy = &stockReturnData.ReceivedBy.String
+	}
+
+	if stockReturnData.InspectedAt.Valid {
+		payload.InspectedAt = &stockReturnData.InspectedAt.Time
+		payload.InspectedBy = &stockReturnData.InspectedBy.String
+	}
+
+	if stockReturnData.CancelledAt.Valid {
+		payload.CancelledAt = &stockReturnData.CancelledAt.Time
+		payload.CancelledBy = &stockReturnData.CancelledBy.String
+	}
+
+	if stockReturnData.UpdatedAt.Valid {
+		payload.UpdatedAt = &stockReturnData.UpdatedAt.Time
+		payload.UpdatedBy = &stockReturnData.UpdatedBy.String
+	}
+
+	if listOutcome != nil {
+		payload.Outcomes = make([]*readStockReturnOutcomePayload, len(listOutcome))
+
+		for i := range listOutcome {
+			payload.Outcomes[i] = &readStockReturnOutcomePayload{
+				GUID:        listOutcome[i].Guid,
+				Disposition: listOutcome[i].Disposition,
+				Quantity:    listOutcome[i].Quantity,
+				CreatedAt:   listOutcome[i].CreatedAt,
+				CreatedBy:   listOutcome[i].CreatedBy,
+			}
+
+			if listOutcome[i].ReasonCode.Valid {
+				payload.Outcomes[i].ReasonCode = &listOutcome[i].ReasonCode.String
+			}
+
+			if listOutcome[i].BinGuid.Valid {
+				payload.Outcomes[i].BinID = &listOutcome[i].BinGuid.String
+			}
+
+			if listOutcome[i].ProductsHistoryGuid.Valid {
+				payload.Outcomes[i].ProductHistoryID = &listOutcome[i].ProductsHistoryGuid.String
+			}
+
+			if listOutcome[i].Notes.Valid {
+				payload.Outcomes[i].Notes = &listOutcome[i].Notes.String
+			}
+		}
+	}
+
+	return
+}
+
+func ToPayloadListStockReturn(listStockReturn []sqlc.ListStockReturnRow) (payload []*readStockReturnPayload) {
+	payload = make([]*readStockReturnPayload, len(listStockReturn))
+
+	for i := range listStockReturn {
+		payload[i] = new(readStockReturnPayload)
+		data := ToPayloadStockReturn(sqlc.GetStockReturnRow(listStockReturn[i]), nil)
+		payload[i] = &data
+	}
+
+	return
+}
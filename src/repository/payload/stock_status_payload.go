@@ -0,0 +1,260 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type MoveStockStatusPayload struct {
+	ProductID   string `json:"product_id" valid:"required"`
+	WarehouseID string `json:"warehouse_id" valid:"required"`
+	FromStatus  string `json:"from_status" valid:"required"` // available, quarantine, damaged, on_hold
+	ToStatus    string `json:"to_status" valid:"required"`   // available, quarantine, damaged, on_hold
+	Quantity    int64  `json:"quantity" valid:"required"`
+	ReasonCode  string `json:"reason_code" valid:"required"` // inspection, damaged, quality_hold, recall, released, other
+	Notes       string `json:"notes"`
+}
+
+type ReadStockStatusBalancePayload struct {
+	ProductID   string `query:"product_id" valid:"required"`
+	WarehouseID string `query:"warehouse_id" valid:"required"`
+}
+
+type ListStockStatusMovePayload struct {
+	Filter ListStockStatusMoveFilterPayload `json:"filter"`
+	Limit  int32                            `json:"limit" valid:"required"`
+	Offset int32                            `json:"page" valid:"required"`
+	Order  string                           `json:"order" valid:"required"`
+	Sort   string                           `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListStockStatusMoveFilterPayload struct {
+	SetProduct     bool   `json:"set_product"`
+	ProductID      string `json:"product_id"`
+	SetWarehouse   bool   `json:"set_warehouse"`
+	WarehouseID    string `json:"warehouse_id"`
+	SetStockStatus bool   `json:"set_stock_status"`
+	StockStatus    string `json:"stock_status"` // matches moves from or to the status
+	SetReasonCode  bool   `json:"set_reason_code"`
+	ReasonCode     string `json:"reason_code"`
+}
+
+type readStockStatusBalancePayload struct {
+	ProductID          string `json:"product_id"`
+	WarehouseID        string `json:"warehouse_id"`
+	Quantity           int64  `json:"quantity"` // on-hand in every status
+	AvailableQuantity  int64  `json:"available_quantity"`
+	QuarantineQuantity int64  `json:"quarantine_quantity"`
+	DamagedQuantity    int64  `json:"damaged_quantity"`
+	OnHoldQuantity     int64  `json:"on_hold_quantity"`
+	ReservedQuantity   int64  `json:"reserved_quantity"` // taken from the available quantity
+}
+
+type readStockStatusMovePayload struct {
+	GUID          string    `json:"id"`
+	ProductID     string    `json:"product_id"`
+	ProductName   string    `json:"product_name"`
+	WarehouseID   string    `json:"warehouse_id"`
+	WarehouseCode string    `json:"warehouse_code"`
+	WarehouseName string    `json:"warehouse_name"`
+	FromStatus    string    `json:"from_status"`
+	ToStatus      string    `json:"to_status"`
+	Quantity      int64     `json:"quantity"`
+	ReasonCode    string    `json:"reason_code"`
+	Notes         *string   `json:"notes"`
+	CreatedAt     time.Time `json:"created_at"`
+	CreatedBy     string    `json:"created_by"`
+}
+
+// isStockStatus reports whether the status is one stock can be held in.
+func isStockStatus(status string) bool {
+	switch status {
+	case constants.StockStatusAvailable, constants.StockStatusQuarantine, constants.StockStatusDamaged, constants.StockStatusOnHold:
+		return true
+	}
+
+	return false
+}
+
+func (payload *MoveStockStatusPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Quantity <= 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+		return
+	}
+
+	if !isStockStatus(payload.FromStatus) || !isStockStatus(payload.ToStatus) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid stock status")
+		return
+	}
+
+	if payload.FromStatus == payload.ToStatus {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: from and to status must differ")
+		return
+	}
+
+	switch payload.ReasonCode {
+	case constants.StockStatusReasonInspection, constants.StockStatusReasonDamaged, constants.StockStatusReasonQualityHold,
+		constants.StockStatusReasonRecall, constants.StockStatusReasonReleased, constants.StockStatusReasonOther:
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid reason code")
+		return
+	}
+
+	return
+}
+
+func (payload *ReadStockStatusBalancePayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *ListStockStatusMovePayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStockStatus && !isStockStatus(payload.Filter.StockStatus) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid stock status")
+		return
+	}
+
+	return
+}
+
+func (payload *MoveStockStatusPayload) ToEntity(userGUID string) (data sqlc.InsertStockStatusMoveParams) {
+	data = sqlc.InsertStockStatusMoveParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   payload.ProductID,
+		WarehouseGuid: payload.WarehouseID,
+		FromStatus:    payload.FromStatus,
+		ToStatus:      payload.ToStatus,
+		Quantity:      payload.Quantity,
+		ReasonCode:    payload.ReasonCode,
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+	}
+
+	return
+}
+
+func (payload *ListStockStatusMovePayload) ToEntity() (data sqlc.ListStockStatusMoveParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListStockStatusMoveParams{
+		SetProduct:     payload.Filter.SetProduct,
+		ProductGuid:    payload.Filter.ProductID,
+		SetWarehouse:   payload.Filter.SetWarehouse,
+		WarehouseGuid:  payload.Filter.WarehouseID,
+		SetStockStatus: payload.Filter.SetStockStatus,
+		StockStatus:    payload.Filter.StockStatus,
+		SetReasonCode:  payload.Filter.SetReasonCode,
+		ReasonCode:     payload.Filter.ReasonCode,
+		LimitData:      payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+// ToPayloadStockStatusBalance splits the on-hand quantity by status, whatever is not held
+// in another status is available.
+func ToPayloadStockStatusBalance(balance sqlc.StockBalance, listHeld []sqlc.StockStatusBalance, reserved int64) (payload readStockStatusBalancePayload) {
+	payload = readStockStatusBalancePayload{
+		ProductID:         balance.ProductGuid,
+		WarehouseID:       balance.WarehouseGuid,
+		Quantity:          balance.Quantity,
+		AvailableQuantity: balance.Quantity,
+		ReservedQuantity:  reserved,
+	}
+
+	for i := range listHeld {
+		switch listHeld[i].StockStatus {
+		case constants.StockStatusQuarantine:
+			payload.QuarantineQuantity = listHeld[i].Quantity
+		case constants.StockStatusDamaged:
+			payload.DamagedQuantity = listHeld[i].Quantity
+		case constants.StockStatusOnHold:
+			payload.OnHoldQuantity = listHeld[i].Quantity
+		}
+
+		payload.AvailableQuantity -= listHeld[i].Quantity
+	}
+
+	return
+}
+
+func ToPayloadStockStatusMove(stockStatusMoveData sqlc.GetStockStatusMoveRow) (payload readStockStatusMovePayload) {
+	payload = readStockStatusMovePayload{
+		GUID:          stockStatusMoveData.Guid,
+		ProductID:     stockStatusMoveData.ProductGuid,
+		ProductName:   stockStatusMoveData.ProductName.String,
+		WarehouseID:   stockStatusMoveData.WarehouseGuid,
+		WarehouseCode: stockStatusMoveData.WarehouseCode.String,
+		WarehouseName: stockStatusMoveData.WarehouseName.String,
+		FromStatus:    stockStatusMoveData.FromStatus,
+		ToStatus:      stockStatusMoveData.ToStatus,
+		Quantity:      stockStatusMoveData.Quantity,
+		ReasonCode:    stockStatusMoveData.ReasonCode,
+		CreatedAt:     stockStatusMoveData.CreatedAt,
+		CreatedBy:     stockStatusMoveData.CreatedBy,
+	}
+
+	if stockStatusMoveData.Notes.Valid {
+		payload.Notes = &stockStatusMoveData.Notes.String
+	}
+
+	return
+}
+
+func ToPayloadListStockStatusMove(listStockStatusMove []sqlc.ListStockStatusMoveRow) (payload []*readStockStatusMovePayload) {
+	payload = make([]*readStockStatusMovePayload, len(listStockStatusMove))
+
+	for i := range listStockStatusMove {
+		payload[i] = new(readStockStatusMovePayload)
+		data := ToPayloadStockStatusMove(sqlc.GetStockStatusMoveRow(listStockStatusMove[i]))
+		payload[i] = &data
+	}
+
+	return
+}
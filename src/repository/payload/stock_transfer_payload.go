@@ -0,0 +1,347 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type InsertStockTransferPayload struct {
+	SourceWarehouseID      string                           `json:"source_warehouse_id" valid:"required"`
+	DestinationWarehouseID string                           `json:"destination_warehouse_id" valid:"required"`
+	Notes                  string                           `json:"notes"`
+	Items                  []InsertStockTransferItemPayload `json:"items" valid:"required"`
+}
+
+type InsertStockTransferItemPayload struct {
+	ProductID string `json:"product_id" valid:"required"`
+	Quantity  int64  `json:"quantity" valid:"required"`
+}
+
+type DispatchStockTransferPayload struct {
+	Items []DispatchStockTransferItemPayload `json:"items"`
+}
+
+// DispatchStockTransferItemPayload carries the serials scanned for a serialized line.
+type DispatchStockTransferItemPayload struct {
+	ItemID        string   `json:"item_id" valid:"required"`
+	SerialNumbers []string `json:"serial_numbers"`
+}
+
+type ReceiveStockTransferPayload struct {
+	Items []ReceiveStockTransferItemPayload `json:"items"`
+}
+
+// ReceiveStockTransferItemPayload records the quantity actually counted at the destination,
+// which may be lower (short) or higher (over) than the dispatched quantity.
+type ReceiveStockTransferItemPayload struct {
+	ItemID           string `json:"item_id" valid:"required"`
+	ReceivedQuantity int64  `json:"received_quantity"`
+}
+
+type ListStockTransferPayload struct {
+	Filter ListStockTransferFilterPayload `json:"filter"`
+	Limit  int32                          `json:"limit" valid:"required"`
+	Offset int32                          `json:"page" valid:"required"`
+	Order  string                         `json:"order" valid:"required"`
+	Sort   string                         `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListStockTransferFilterPayload struct {
+	SetTransferCode         bool   `json:"set_transfer_code"`
+	TransferCode            string `json:"transfer_code"`
+	SetStatus               bool   `json:"set_status"`
+	Status                  string `json:"status"` // draft, in_transit, received, cancelled
+	SetSourceWarehouse      bool   `json:"set_source_warehouse"`
+	SourceWarehouseID       string `json:"source_warehouse_id"`
+	SetDestinationWarehouse bool   `json:"set_destination_warehouse"`
+	DestinationWarehouseID  string `json:"destination_warehouse_id"`
+}
+
+type readStockTransferPayload struct {
+	GUID                 string                          `json:"id"`
+	TransferCode         string                          `json:"transfer_code"`
+	SourceWarehouse      readStockTransferWarehouse      `json:"source_warehouse"`
+	DestinationWarehouse readStockTransferWarehouse      `json:"destination_warehouse"`
+	Status               string                          `json:"status"`
+	Notes                string                          `json:"notes"`
+	Items                []*readStockTransferItemPayload `json:"items,omitempty"`
+	DispatchedAt         *time.Time                      `json:"dispatched_at"`
+	DispatchedBy         *string                         `json:"dispatched_by"`
+	ReceivedAt           *time.Time                      `json:"received_at"`
+	ReceivedBy           *string                         `json:"received_by"`
+	CancelledAt          *time.Time                      `json:"cancelled_at"`
+	CancelledBy          *string                         `json:"cancelled_by"`
+	CreatedAt            time.Time                       `json:"created_at"`
+	CreatedBy            string                          `json:"created_by"`
+	UpdatedAt            *time.Time                      `json:"updated_at"`
+	UpdatedBy            *string                         `json:"updated_by"`
+}
+
+type readStockTransferWarehouse struct {
+	GUID string `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+type readStockTransferItemPayload struct {
+	GUID             string `json:"id"`
+	ProductID        string `json:"product_id"`
+	ProductName      string `json:"product_name"`
+	Quantity         int64  `json:"quantity"`
+	ReceivedQuantity *int64 `json:"received_quantity"`
+	Variance         *int64 `json:"variance"`
+}
+
+func (payload *InsertStockTransferPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.SourceWarehouseID == payload.DestinationWarehouseID {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: source and destination warehouse must be different")
+		return
+	}
+
+	if len(payload.Items) == 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: items is required")
+		return
+	}
+
+	products := make(map[string]bool, len(payload.Items))
+	for _, item := range payload.Items {
+		if item.Quantity <= 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: quantity must be greater than zero")
+			return
+		}
+
+		if products[item.ProductID] {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: duplicate product %s", item.ProductID)
+			return
+		}
+
+		products[item.ProductID] = true
+	}
+
+	return
+}
+
+func (payload *DispatchStockTransferPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *ReceiveStockTransferPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	for _, item := range payload.Items {
+		if item.ReceivedQuantity < 0 {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: received quantity must not be negative")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *ListStockTransferPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetStatus {
+		switch payload.Filter.Status {
+		case constants.StockTransferStatusDraft, constants.StockTransferStatusInTransit,
+			constants.StockTransferStatusReceived, constants.StockTransferStatusCancelled:
+		default:
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid status")
+			return
+		}
+	}
+
+	return
+}
+
+func (payload *InsertStockTransferPayload) ToEntity(userGUID string) (data sqlc.InsertStockTransferParams) {
+	data = sqlc.InsertStockTransferParams{
+		Guid:                     utility.GenerateGoogleUUID(),
+		TransferCode:             utility.GenerateDocumentNumber(constants.StockTransferCodePrefix),
+		SourceWarehouseGuid:      payload.SourceWarehouseID,
+		DestinationWarehouseGuid: payload.DestinationWarehouseID,
+		Notes: sql.NullString{
+			String: payload.Notes,
+			Valid:  payload.Notes != "",
+		},
+		CreatedBy: userGUID,
+	}
+
+	return
+}
+
+func (payload *InsertStockTransferPayload) ToEntityItems(stockTransferGUID string) (data []sqlc.InsertStockTransferItemParams) {
+	data = make([]sqlc.InsertStockTransferItemParams, len(payload.Items))
+
+	for i := range payload.Items {
+		data[i] = sqlc.InsertStockTransferItemParams{
+			Guid:              utility.GenerateGoogleUUID(),
+			StockTransferGuid: stockTransferGUID,
+			ProductGuid:       payload.Items[i].ProductID,
+			Quantity:          payload.Items[i].Quantity,
+		}
+	}
+
+	return
+}
+
+// ToEntity maps item guid to the serial numbers dispatched with it.
+func (payload *DispatchStockTransferPayload) ToEntity() (data map[string][]string) {
+	data = make(map[string][]string, len(payload.Items))
+
+	for _, item := range payload.Items {
+		data[item.ItemID] = item.SerialNumbers
+	}
+
+	return
+}
+
+// ToEntity maps item guid to the received quantity. Items that are not listed are
+// treated as fully received by the service.
+func (payload *ReceiveStockTransferPayload) ToEntity() (data map[string]int64) {
+	data = make(map[string]int64, len(payload.Items))
+
+	for _, item := range payload.Items {
+		data[item.ItemID] = item.ReceivedQuantity
+	}
+
+	return
+}
+
+func (payload *ListStockTransferPayload) ToEntity() (data sqlc.ListStockTransferParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListStockTransferParams{
+		SetTransferCode:          payload.Filter.SetTransferCode,
+		TransferCode:             "%" + payload.Filter.TransferCode + "%",
+		SetStatus:                payload.Filter.SetStatus,
+		Status:                   payload.Filter.Status,
+		SetSourceWarehouse:       payload.Filter.SetSourceWarehouse,
+		SourceWarehouseGuid:      payload.Filter.SourceWarehouseID,
+		SetDestinationWarehouse:  payload.Filter.SetDestinationWarehouse,
+		DestinationWarehouseGuid: payload.Filter.DestinationWarehouseID,
+		LimitData:                payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func ToPayloadStockTransfer(stockTransferData sqlc.GetStockTransferRow, listItem []sqlc.ListStockTransferItemRow) (payload readStockTransferPayload) {
+	payload = readStockTransferPayload{
+		GUID:         stockTransferData.Guid,
+		TransferCode: stockTransferData.TransferCode,
+		SourceWarehouse: readStockTransferWarehouse{
+			GUID: stockTransferData.SourceWarehouseGuid,
+			Code: stockTransferData.SourceWarehouseCode.String,
+			Name: stockTransferData.SourceWarehouseName.String,
+		},
+		DestinationWarehouse: readStockTransferWarehouse{
+			GUID: stockTransferData.DestinationWarehouseGuid,
+			Code: stockTransferData.DestinationWarehouseCode.String,
+			Name: stockTransferData.DestinationWarehouseName.String,
+		},
+		Status:    stockTransferData.Status,
+		Notes:     stockTransferData.Notes.String,
+		CreatedAt: stockTransferData.CreatedAt,
+		CreatedBy: stockTransferData.CreatedBy,
+	}
+
+	if stockTransferData.DispatchedAt.Valid {
+		payload.DispatchedAt = &stockTransferData.DispatchedAt.Time
+		payload.DispatchedBy = &stockTransferData.DispatchedBy.String
+	}
+
+	if stockTransferData.ReceivedAt.Valid {
+		payload.ReceivedAt = &stockTransferData.ReceivedAt.Time
+		payload.ReceivedBy = &stockTransferData.ReceivedBy.String
+	}
+
+	if stockTransferData.CancelledAt.Valid {
+		payload.CancelledAt = &stockTransferData.CancelledAt.Time
+		payload.CancelledBy = &stockTransferData.CancelledBy.String
+	}
+
+	if stockTransferData.UpdatedAt.Valid {
+		payload.UpdatedAt = &stockTransferData.UpdatedAt.Time
+		payload.UpdatedBy = &stockTransferData.UpdatedBy.String
+	}
+
+	if listItem != nil {
+		payload.Items = make([]*readStockTransferItemPayload, len(listItem))
+
+		for i := range listItem {
+			payload.Items[i] = &readStockTransferItemPayload{
+				GUID:        listItem[i].Guid,
+				ProductID:   listItem[i].ProductGuid,
+				ProductName: listItem[i].ProductName.String,
+				Quantity:    listItem[i].Quantity,
+			}
+
+			if listItem[i].ReceivedQuantity.Valid {
+				variance := listItem[i].ReceivedQuantity.Int64 - listItem[i].Quantity
+				payload.Items[i].ReceivedQuantity = &listItem[i].ReceivedQuantity.Int64
+				payload.Items[i].Variance = &variance
+			}
+		}
+	}
+
+	return
+}
+
+func ToPayloadListStockTransfer(listStockTransfer []sqlc.ListStockTransferRow) (payload []*readStockTransferPayload) {
+	payload = make([]*readStockTransferPayload, len(listStockTransfer))
+
+	for i := range listStockTransfer {
+		payload[i] = new(readStockTransferPayload)
+		data := ToPayloadStockTransfer(sqlc.GetStockTransferRow(listStockTransfer[i]), nil)
+		payload[i] = &data
+	}
+
+	return
+}
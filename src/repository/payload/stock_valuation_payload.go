@@ -0,0 +1,150 @@
+package payload
+
+import (
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type ReadStockValuationReportPayload struct {
+	// AsOf is a date (2006-01-02, the whole day counts) or an RFC 3339 time, empty means now
+	AsOf        string `query:"as_of"`
+	WarehouseID string `query:"warehouse_id"` // empty reports every warehouse
+	Limit       int32  `query:"limit"`
+	Offset      int32  `query:"page"`
+
+	asOf time.Time
+}
+
+// The report total covers every row, the warehouse figures only the rows on the page.
+type readStockValuationReportPayload struct {
+	AsOf       time.Time                             `json:"as_of"`
+	TotalValue int64                                 `json:"total_value"`
+	Warehouses []*readStockValuationWarehousePayload `json:"warehouses"`
+}
+
+type readStockValuationWarehousePayload struct {
+	WarehouseID   string                              `json:"warehouse_id"`
+	WarehouseCode string                              `json:"warehouse_code"`
+	WarehouseName string                              `json:"warehouse_name"`
+	Quantity      int64                               `json:"quantity"`
+	TotalValue    int64                               `json:"total_value"`
+	Products      []*readStockValuationProductPayload `json:"products"`
+}
+
+type readStockValuationProductPayload struct {
+	ProductID   string `json:"product_id"`
+	ProductName string `json:"product_name"`
+	Sku         string `json:"sku"`
+	Quantity    int64  `json:"quantity"`
+	TotalValue  int64  `json:"total_value"`
+	AverageCost int64  `json:"average_cost"`
+}
+
+func (payload *ReadStockValuationReportPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Limit == 0 {
+		payload.Limit = 10
+	}
+
+	if payload.Offset == 0 {
+		payload.Offset = 1
+	}
+
+	if payload.Limit < 1 || payload.Offset < 1 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: limit and page must be at least 1")
+		return
+	}
+
+	if payload.AsOf == "" {
+		payload.asOf = time.Now().UTC()
+		return
+	}
+
+	if payload.asOf, err = parseAsOf(payload.AsOf); err != nil {
+		return
+	}
+
+	return
+}
+
+// parseAsOf reads a point in time in UTC. A bare date stands for the end of that day, so
+// every movement booked on it is included.
+func parseAsOf(value string) (asOf time.Time, err error) {
+	if date, errParse := time.Parse("2006-01-02", value); errParse == nil {
+		asOf = date.AddDate(0, 0, 1).Add(-time.Microsecond)
+		return
+	}
+
+	asOf, err = time.Parse(time.RFC3339, value)
+	if err != nil {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: as_of must be a date or an RFC 3339 time")
+		return
+	}
+
+	asOf = asOf.UTC()
+
+	return
+}
+
+func (payload *ReadStockValuationReportPayload) ToEntity() (data sqlc.ListStockValuationAsOfParams) {
+	data = sqlc.ListStockValuationAsOfParams{
+		AsOf:          payload.asOf,
+		SetWarehouse:  payload.WarehouseID != "",
+		WarehouseGuid: payload.WarehouseID,
+		OffsetPage:    (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:     payload.Limit,
+	}
+
+	return
+}
+
+// ToPayloadStockValuationReport groups the valuation rows, which come ordered by warehouse, per warehouse.
+func ToPayloadStockValuationReport(asOf time.Time, totalValue int64, listValuation []sqlc.ListStockValuationAsOfRow) (payload readStockValuationReportPayload) {
+	payload = readStockValuationReportPayload{
+		AsOf:       asOf,
+		TotalValue: totalValue,
+		Warehouses: make([]*readStockValuationWarehousePayload, 0),
+	}
+
+	warehouseIndex := make(map[string]*readStockValuationWarehousePayload)
+
+	for i := range listValuation {
+		warehouse, ok := warehouseIndex[listValuation[i].WarehouseGuid]
+		if !ok {
+			warehouse = &readStockValuationWarehousePayload{
+				WarehouseID:   listValuation[i].WarehouseGuid,
+				WarehouseCode: listValuation[i].WarehouseCode.String,
+				WarehouseName: listValuation[i].WarehouseName.String,
+			}
+
+			warehouseIndex[listValuation[i].WarehouseGuid] = warehouse
+			payload.Warehouses = append(payload.Warehouses, warehouse)
+		}
+
+		product := &readStockValuationProductPayload{
+			ProductID:   listValuation[i].ProductGuid,
+			ProductName: listValuation[i].ProductName.String,
+			Sku:         listValuation[i].Sku.String,
+			Quantity:    listValuation[i].Quantity,
+			TotalValue:  listValuation[i].TotalValue,
+		}
+
+		if product.Quantity > 0 {
+			product.AverageCost = product.TotalValue / product.Quantity
+		}
+
+		warehouse.Products = append(warehouse.Products, product)
+		warehouse.Quantity += product.Quantity
+		warehouse.TotalValue += product.TotalValue
+	}
+
+	return
+}
@@ -0,0 +1,65 @@
+package payload
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAsOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "reads a bare date as the end of that day",
+			value: "2026-03-31",
+			want:  time.Date(2026, time.March, 31, 23, 59, 59, 999999000, time.UTC),
+		},
+		{
+			name:  "rolls the end of the day over a leap day",
+			value: "2024-02-29",
+			want:  time.Date(2024, time.February, 29, 23, 59, 59, 999999000, time.UTC),
+		},
+		{
+			name:  "reads an RFC 3339 time as given",
+			value: "2026-03-31T10:15:00Z",
+			want:  time.Date(2026, time.March, 31, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			name:  "converts an RFC 3339 time with offset to UTC",
+			value: "2026-04-01T07:00:00+07:00",
+			want:  time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "rejects an empty value",
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "rejects a date in another layout",
+			value:   "31/03/2026",
+			wantErr: true,
+		},
+		{
+			name:    "rejects a date that does not exist",
+			value:   "2026-02-30",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAsOf(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseAsOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !got.Equal(tt.want) || (!tt.wantErr && got.Location() != time.UTC) {
+				t.Errorf("parseAsOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
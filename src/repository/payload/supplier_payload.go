@@ -0,0 +1,422 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type RegisterSupplierPayload struct {
+	SupplierCode string `json:"supplier_code" valid:"required"`
+	Name         string `json:"name" valid:"required"`
+	ContactName  string `json:"contact_name"`
+	Email        string `json:"email" valid:"email"`
+	PhoneNumber  string `json:"phone_number"`
+	Address      string `json:"address" valid:"required"`
+	TaxID        string `json:"tax_id"`
+}
+
+type UpdateSupplierPayload struct {
+	Name        string `json:"name" valid:"required"`
+	ContactName string `json:"contact_name"`
+	Email       string `json:"email" valid:"email"`
+	PhoneNumber string `json:"phone_number"`
+	Address     string `json:"address" valid:"required"`
+	TaxID       string `json:"tax_id"`
+}
+
+type ListSupplierPayload struct {
+	Filter ListSupplierFilterPayload `json:"filter"`
+	Limit  int32                     `json:"limit" valid:"required"`
+	Offset int32                     `json:"page" valid:"required"`
+	Order  string                    `json:"order" valid:"required"`
+	Sort   string                    `json:"sort" valid:"required"` // ASC, DESC
+}
+
+type ListSupplierFilterPayload struct {
+	SetName         bool   `json:"set_name"`
+	Name            string `json:"name"`
+	SetSupplierCode bool   `json:"set_supplier_code"`
+	SupplierCode    string `json:"supplier_code"`
+	SetActive       bool   `json:"set_active"`
+	Active          string `json:"active"` // active, inactive
+}
+
+type UpsertProductSupplierPayload struct {
+	SupplierSku  string `json:"supplier_sku"`
+	LeadTimeDays int32  `json:"lead_time_days"`
+}
+
+type ListProductSupplierPayload struct {
+	Limit  int32 `query:"limit"`
+	Offset int32 `query:"page"`
+}
+
+type readSupplierPayload struct {
+	GUID         string                   `json:"id"`
+	SupplierCode string                   `json:"supplier_code"`
+	Name         string                   `json:"name"`
+	ContactName  *string                  `json:"contact_name"`
+	Email        *string                  `json:"email"`
+	PhoneNumber  *string                  `json:"phone_number"`
+	Address      string                   `json:"address"`
+	TaxID        *string                  `json:"tax_id"`
+	Status       string                   `json:"status"`
+	CreatedAt    time.Time                `json:"created_at"`
+	CreatedBy    readUserSupplierPayload  `json:"created_by"`
+	UpdatedAt    *time.Time               `json:"updated_at"`
+	UpdatedBy    *readUserSupplierPayload `json:"updated_by"`
+}
+
+type readUserSupplierPayload struct {
+	GUID string `json:"id"`
+	Name string `json:"name"`
+}
+
+type readProductSupplierPayload struct {
+	GUID         string     `json:"id"`
+	ProductID    string     `json:"product_id"`
+	ProductName  *string    `json:"product_name,omitempty"`
+	ProductSku   *string    `json:"product_sku,omitempty"`
+	SupplierID   string     `json:"supplier_id"`
+	SupplierCode *string    `json:"supplier_code,omitempty"`
+	SupplierName *string    `json:"supplier_name,omitempty"`
+	SupplierSku  *string    `json:"supplier_sku"`
+	LeadTimeDays int32      `json:"lead_time_days"`
+	Status       string     `json:"status,omitempty"` // supplier status, product view only
+	CreatedAt    time.Time  `json:"created_at"`
+	CreatedBy    string     `json:"created_by"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	UpdatedBy    *string    `json:"updated_by"`
+}
+
+func (payload *RegisterSupplierPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *UpdateSupplierPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *ListSupplierPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Filter.SetActive && payload.Filter.Active != constants.StatusActive && payload.Filter.Active != constants.StatusInactive {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid active filter")
+		return
+	}
+
+	return
+}
+
+func (payload *UpsertProductSupplierPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.LeadTimeDays < 0 {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: lead time must not be negative")
+		return
+	}
+
+	return
+}
+
+func (payload *ListProductSupplierPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	if payload.Limit == 0 {
+		payload.Limit = 10
+	}
+
+	if payload.Offset == 0 {
+		payload.Offset = 1
+	}
+
+	return
+}
+
+func (payload *RegisterSupplierPayload) ToEntity(userData sqlc.GetUserBackofficeRow) (data sqlc.InsertSupplierParams) {
+	data = sqlc.InsertSupplierParams{
+		Guid:         utility.GenerateGoogleUUID(),
+		SupplierCode: payload.SupplierCode,
+		Name:         payload.Name,
+		ContactName: sql.NullString{
+			String: payload.ContactName,
+			Valid:  payload.ContactName != "",
+		},
+		Email: sql.NullString{
+			String: payload.Email,
+			Valid:  payload.Email != "",
+		},
+		PhoneNumber: sql.NullString{
+			String: payload.PhoneNumber,
+			Valid:  payload.PhoneNumber != "",
+		},
+		Address: payload.Address,
+		TaxID: sql.NullString{
+			String: payload.TaxID,
+			Valid:  payload.TaxID != "",
+		},
+		CreatedBy: userData.Guid,
+	}
+
+	return
+}
+
+func (payload *UpdateSupplierPayload) ToEntity(userData sqlc.GetUserBackofficeRow, guid string) (data sqlc.UpdateSupplierParams) {
+	data = sqlc.UpdateSupplierParams{
+		Guid: guid,
+		Name: payload.Name,
+		ContactName: sql.NullString{
+			String: payload.ContactName,
+			Valid:  payload.ContactName != "",
+		},
+		Email: sql.NullString{
+			String: payload.Email,
+			Valid:  payload.Email != "",
+		},
+		PhoneNumber: sql.NullString{
+			String: payload.PhoneNumber,
+			Valid:  payload.PhoneNumber != "",
+		},
+		Address: payload.Address,
+		TaxID: sql.NullString{
+			String: payload.TaxID,
+			Valid:  payload.TaxID != "",
+		},
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+	}
+
+	return
+}
+
+func (payload *ListSupplierPayload) ToEntity() (data sqlc.ListSupplierParams) {
+	orderParam := constants.DefaultOrderValue
+
+	data = sqlc.ListSupplierParams{
+		SetName:         payload.Filter.SetName,
+		Name:            "%" + payload.Filter.Name + "%",
+		SetSupplierCode: payload.Filter.SetSupplierCode,
+		SupplierCode:    "%" + payload.Filter.SupplierCode + "%",
+		SetActive:       payload.Filter.SetActive,
+		Active:          payload.Filter.Active,
+		LimitData:       payload.Limit,
+	}
+
+	if payload.Limit == 0 {
+		data.LimitData = 10
+	}
+
+	if payload.Offset == 0 {
+		data.OffsetPage = (1 * data.LimitData) - data.LimitData
+	} else {
+		data.OffsetPage = (payload.Offset * data.LimitData) - data.LimitData
+	}
+
+	if payload.Order != "" {
+		orderParam = payload.Order + " ASC"
+
+		if payload.Sort != "" {
+			orderParam = payload.Order + " " + payload.Sort
+		}
+	}
+
+	data.OrderParam = orderParam
+
+	return
+}
+
+func (payload *UpsertProductSupplierPayload) ToEntity(userData sqlc.GetUserBackofficeRow, supplierGUID string, productGUID string) (data sqlc.UpsertProductSupplierParams) {
+	data = sqlc.UpsertProductSupplierParams{
+		Guid:         utility.GenerateGoogleUUID(),
+		ProductGuid:  productGUID,
+		SupplierGuid: supplierGUID,
+		SupplierSku: sql.NullString{
+			String: payload.SupplierSku,
+			Valid:  payload.SupplierSku != "",
+		},
+		LeadTimeDays: payload.LeadTimeDays,
+		CreatedBy:    userData.Guid,
+	}
+
+	return
+}
+
+func (payload *ListProductSupplierPayload) ToEntity(supplierGUID string) (data sqlc.ListProductSupplierBySupplierParams) {
+	data = sqlc.ListProductSupplierBySupplierParams{
+		SupplierGuid: supplierGUID,
+		OffsetPage:   (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:    payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadSupplier(supplierData sqlc.GetSupplierRow) (payload readSupplierPayload) {
+	payload = readSupplierPayload{
+		GUID:         supplierData.Guid,
+		SupplierCode: supplierData.SupplierCode,
+		Name:         supplierData.Name,
+		Address:      supplierData.Address,
+		CreatedAt:    supplierData.CreatedAt,
+		CreatedBy: readUserSupplierPayload{
+			GUID: supplierData.CreatedBy,
+			Name: supplierData.UserName.String,
+		},
+	}
+
+	if supplierData.ContactName.Valid {
+		payload.ContactName = &supplierData.ContactName.String
+	}
+
+	if supplierData.Email.Valid {
+		payload.Email = &supplierData.Email.String
+	}
+
+	if supplierData.PhoneNumber.Valid {
+		payload.PhoneNumber = &supplierData.PhoneNumber.String
+	}
+
+	if supplierData.TaxID.Valid {
+		payload.TaxID = &supplierData.TaxID.String
+	}
+
+	if supplierData.UpdatedAt.Valid {
+		payload.UpdatedAt = &supplierData.UpdatedAt.Time
+	}
+
+	if supplierData.UpdatedBy.Valid {
+		payload.UpdatedBy = &readUserSupplierPayload{
+			GUID: supplierData.UpdatedBy.String,
+			Name: supplierData.UserNameUpdate.String,
+		}
+	}
+
+	if supplierData.DeletedAt.Valid {
+		payload.Status = constants.StatusInactive
+	} else {
+		payload.Status = constants.StatusActive
+	}
+
+	return
+}
+
+func ToPayloadListSupplier(listSupplier []sqlc.ListSupplierRow) (payload []*readSupplierPayload) {
+	payload = make([]*readSupplierPayload, len(listSupplier))
+
+	for i := range listSupplier {
+		payload[i] = new(readSupplierPayload)
+		data := ToPayloadSupplier(sqlc.GetSupplierRow(listSupplier[i]))
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadProductSupplier(productSupplierData sqlc.ProductSupplier) (payload readProductSupplierPayload) {
+	payload = readProductSupplierPayload{
+		GUID:         productSupplierData.Guid,
+		ProductID:    productSupplierData.ProductGuid,
+		SupplierID:   productSupplierData.SupplierGuid,
+		LeadTimeDays: productSupplierData.LeadTimeDays,
+		CreatedAt:    productSupplierData.CreatedAt,
+		CreatedBy:    productSupplierData.CreatedBy,
+	}
+
+	if productSupplierData.SupplierSku.Valid {
+		payload.SupplierSku = &productSupplierData.SupplierSku.String
+	}
+
+	if productSupplierData.UpdatedAt.Valid {
+		payload.UpdatedAt = &productSupplierData.UpdatedAt.Time
+		payload.UpdatedBy = &productSupplierData.UpdatedBy.String
+	}
+
+	return
+}
+
+func ToPayloadListProductSupplierBySupplier(listProductSupplier []sqlc.ListProductSupplierBySupplierRow) (payload []*readProductSupplierPayload) {
+	payload = make([]*readProductSupplierPayload, len(listProductSupplier))
+
+	for i := range listProductSupplier {
+		data := ToPayloadProductSupplier(sqlc.ProductSupplier{
+			Guid:         listProductSupplier[i].Guid,
+			ProductGuid:  listProductSupplier[i].ProductGuid,
+			SupplierGuid: listProductSupplier[i].SupplierGuid,
+			SupplierSku:  listProductSupplier[i].SupplierSku,
+			LeadTimeDays: listProductSupplier[i].LeadTimeDays,
+			CreatedAt:    listProductSupplier[i].CreatedAt,
+			CreatedBy:    listProductSupplier[i].CreatedBy,
+			UpdatedAt:    listProductSupplier[i].UpdatedAt,
+			UpdatedBy:    listProductSupplier[i].UpdatedBy,
+		})
+
+		data.ProductName = &listProductSupplier[i].ProductName.String
+		data.ProductSku = &listProductSupplier[i].ProductSku.String
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadListProductSupplierByProduct(listProductSupplier []sqlc.ListProductSupplierByProductRow) (payload []*readProductSupplierPayload) {
+	payload = make([]*readProductSupplierPayload, len(listProductSupplier))
+
+	for i := range listProductSupplier {
+		data := ToPayloadProductSupplier(sqlc.ProductSupplier{
+			Guid:         listProductSupplier[i].Guid,
+			ProductGuid:  listProductSupplier[i].ProductGuid,
+			SupplierGuid: listProductSupplier[i].SupplierGuid,
+			SupplierSku:  listProductSupplier[i].SupplierSku,
+			LeadTimeDays: listProductSupplier[i].LeadTimeDays,
+			CreatedAt:    listProductSupplier[i].CreatedAt,
+			CreatedBy:    listProductSupplier[i].CreatedBy,
+			UpdatedAt:    listProductSupplier[i].UpdatedAt,
+			UpdatedBy:    listProductSupplier[i].UpdatedBy,
+		})
+
+		data.SupplierCode = &listProductSupplier[i].SupplierCode.String
+		data.SupplierName = &listProductSupplier[i].SupplierName.String
+
+		if listProductSupplier[i].SupplierDeletedAt.Valid {
+			data.Status = constants.StatusInactive
+		} else {
+			data.Status = constants.StatusActive
+		}
+
+		payload[i] = &data
+	}
+
+	return
+}
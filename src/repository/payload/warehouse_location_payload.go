@@ -0,0 +1,286 @@
+package payload
+
+import (
+	"database/sql"
+	"github.com/asaskevich/govalidator"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"time"
+)
+
+type RegisterWarehouseLocationPayload struct {
+	ParentID     string `json:"parent_id"`
+	LocationType string `json:"location_type" valid:"required"` // zone, rack, bin
+	Code         string `json:"code" valid:"required"`
+	Name         string `json:"name"`
+}
+
+type UpdateWarehouseLocationPayload struct {
+	Code string `json:"code" valid:"required"`
+	Name string `json:"name"`
+}
+
+type ListWarehouseLocationPayload struct {
+	LocationType string `query:"location_type"`
+	ParentID     string `query:"parent_id"`
+	Active       string `query:"active"` // active, inactive
+	Limit        int32  `query:"limit"`
+	Offset       int32  `query:"page"`
+}
+
+type readWarehouseLocationPayload struct {
+	GUID         string     `json:"id"`
+	WarehouseID  string     `json:"warehouse_id"`
+	ParentID     *string    `json:"parent_id"`
+	LocationType string     `json:"location_type"`
+	Code         string     `json:"code"`
+	Name         string     `json:"name"`
+	Status       string     `json:"status"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CreatedBy    string     `json:"created_by"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	UpdatedBy    *string    `json:"updated_by"`
+}
+
+type readStockLocationBalancePayload struct {
+	ProductID     string     `json:"product_id"`
+	ProductName   string     `json:"product_name"`
+	WarehouseID   string     `json:"warehouse_id"`
+	WarehouseCode string     `json:"warehouse_code"`
+	WarehouseName string     `json:"warehouse_name"`
+	BinID         string     `json:"bin_id"`
+	BinCode       string     `json:"bin_code"`
+	Quantity      int64      `json:"quantity"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+}
+
+func (payload *RegisterWarehouseLocationPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	switch payload.LocationType {
+	case constants.WarehouseLocationTypeZone:
+		if payload.ParentID != "" {
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: zone must not have a parent")
+			return
+		}
+	case constants.WarehouseLocationTypeRack, constants.WarehouseLocationTypeBin:
+		if payload.ParentID == "" {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s requires a parent", payload.LocationType)
+			return
+		}
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid location type")
+		return
+	}
+
+	return
+}
+
+func (payload *UpdateWarehouseLocationPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	return
+}
+
+func (payload *ListWarehouseLocationPayload) Validate() (err error) {
+	// Validate Payload
+	if _, err = govalidator.ValidateStruct(payload); err != nil {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s", err.Error())
+		return
+	}
+
+	switch payload.LocationType {
+	case "", constants.WarehouseLocationTypeZone, constants.WarehouseLocationTypeRack, constants.WarehouseLocationTypeBin:
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid location type")
+		return
+	}
+
+	switch payload.Active {
+	case "", constants.StatusActive, constants.StatusInactive:
+	default:
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: invalid active filter")
+		return
+	}
+
+	if payload.Limit == 0 {
+		payload.Limit = 10
+	}
+
+	if payload.Offset == 0 {
+		payload.Offset = 1
+	}
+
+	return
+}
+
+func (payload *RegisterWarehouseLocationPayload) ToEntity(userData sqlc.GetUserBackofficeRow, warehouseGUID string) (data sqlc.InsertWarehouseLocationParams) {
+	data = sqlc.InsertWarehouseLocationParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		WarehouseGuid: warehouseGUID,
+		ParentGuid: sql.NullString{
+			String: payload.ParentID,
+			Valid:  payload.ParentID != "",
+		},
+		LocationType: payload.LocationType,
+		Code:         payload.Code,
+		Name: sql.NullString{
+			String: payload.Name,
+			Valid:  payload.Name != "",
+		},
+		CreatedBy: userData.Guid,
+	}
+
+	return
+}
+
+func (payload *UpdateWarehouseLocationPayload) ToEntity(userData sqlc.GetUserBackofficeRow, warehouseGUID string, guid string) (data sqlc.UpdateWarehouseLocationParams) {
+	data = sqlc.UpdateWarehouseLocationParams{
+		Code: payload.Code,
+		Name: sql.NullString{
+			String: payload.Name,
+			Valid:  payload.Name != "",
+		},
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid:          guid,
+		WarehouseGuid: warehouseGUID,
+	}
+
+	return
+}
+
+func (payload *ListWarehouseLocationPayload) ToEntity(warehouseGUID string) (data sqlc.ListWarehouseLocationParams) {
+	data = sqlc.ListWarehouseLocationParams{
+		WarehouseGuid:   warehouseGUID,
+		SetLocationType: payload.LocationType != "",
+		LocationType:    payload.LocationType,
+		SetParent:       payload.ParentID != "",
+		ParentGuid: sql.NullString{
+			String: payload.ParentID,
+			Valid:  true,
+		},
+		SetActive:  payload.Active != "",
+		Active:     payload.Active,
+		OffsetPage: (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:  payload.Limit,
+	}
+
+	return
+}
+
+func (payload *ListStockBalancePayload) ToEntityBin(binGUID string) (data sqlc.ListStockLocationBalanceByBinParams) {
+	data = sqlc.ListStockLocationBalanceByBinParams{
+		BinGuid:    binGUID,
+		OffsetPage: (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:  payload.Limit,
+	}
+
+	return
+}
+
+func (payload *ListStockBalancePayload) ToEntityProductLocation(productGUID string) (data sqlc.ListStockLocationBalanceByProductParams) {
+	data = sqlc.ListStockLocationBalanceByProductParams{
+		ProductGuid: productGUID,
+		OffsetPage:  (payload.Offset * payload.Limit) - payload.Limit,
+		LimitData:   payload.Limit,
+	}
+
+	return
+}
+
+func ToPayloadWarehouseLocation(warehouseLocationData sqlc.WarehouseLocation) (payload readWarehouseLocationPayload) {
+	payload = readWarehouseLocationPayload{
+		GUID:         warehouseLocationData.Guid,
+		WarehouseID:  warehouseLocationData.WarehouseGuid,
+		LocationType: warehouseLocationData.LocationType,
+		Code:         warehouseLocationData.Code,
+		Name:         warehouseLocationData.Name.String,
+		Status:       constants.StatusActive,
+		CreatedAt:    warehouseLocationData.CreatedAt,
+		CreatedBy:    warehouseLocationData.CreatedBy,
+	}
+
+	if warehouseLocationData.ParentGuid.Valid {
+		payload.ParentID = &warehouseLocationData.ParentGuid.String
+	}
+
+	if warehouseLocationData.DeletedAt.Valid {
+		payload.Status = constants.StatusInactive
+	}
+
+	if warehouseLocationData.UpdatedAt.Valid {
+		payload.UpdatedAt = &warehouseLocationData.UpdatedAt.Time
+		payload.UpdatedBy = &warehouseLocationData.UpdatedBy.String
+	}
+
+	return
+}
+
+func ToPayloadListWarehouseLocation(listWarehouseLocation []sqlc.WarehouseLocation) (payload []*readWarehouseLocationPayload) {
+	payload = make([]*readWarehouseLocationPayload, len(listWarehouseLocation))
+
+	for i := range listWarehouseLocation {
+		payload[i] = new(readWarehouseLocationPayload)
+		data := ToPayloadWarehouseLocation(listWarehouseLocation[i])
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadStockLocationBalance(stockLocationBalanceData sqlc.ListStockLocationBalanceByBinRow) (payload readStockLocationBalancePayload) {
+	payload = readStockLocationBalancePayload{
+		ProductID:     stockLocationBalanceData.ProductGuid,
+		ProductName:   stockLocationBalanceData.ProductName.String,
+		WarehouseID:   stockLocationBalanceData.WarehouseGuid,
+		WarehouseCode: stockLocationBalanceData.WarehouseCode.String,
+		WarehouseName: stockLocationBalanceData.WarehouseName.String,
+		BinID:         stockLocationBalanceData.BinGuid,
+		BinCode:       stockLocationBalanceData.BinCode.String,
+		Quantity:      stockLocationBalanceData.Quantity,
+	}
+
+	if stockLocationBalanceData.UpdatedAt.Valid {
+		payload.UpdatedAt = &stockLocationBalanceData.UpdatedAt.Time
+	}
+
+	return
+}
+
+func ToPayloadListBinStockLocationBalance(listStockLocationBalance []sqlc.ListStockLocationBalanceByBinRow) (payload []*readStockLocationBalancePayload) {
+	payload = make([]*readStockLocationBalancePayload, len(listStockLocationBalance))
+
+	for i := range listStockLocationBalance {
+		payload[i] = new(readStockLocationBalancePayload)
+		data := ToPayloadStockLocationBalance(listStockLocationBalance[i])
+		payload[i] = &data
+	}
+
+	return
+}
+
+func ToPayloadListProductStockLocationBalance(listStockLocationBalance []sqlc.ListStockLocationBalanceByProductRow) (payload []*readStockLocationBalancePayload) {
+	payload = make([]*readStockLocationBalancePayload, len(listStockLocationBalance))
+
+	for i := range listStockLocationBalance {
+		payload[i] = new(readStockLocationBalancePayload)
+		data := ToPayloadStockLocationBalance(sqlc.ListStockLocationBalanceByBinRow(listStockLocationBalance[i]))
+		payload[i] = &data
+	}
+
+	return
+}
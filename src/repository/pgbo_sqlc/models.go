@@ -6,6 +6,7 @@ package sqlc
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
@@ -40,6 +41,42 @@ type Config struct {
 	UpdatedBy   sql.NullString `json:"updated_by"`
 }
 
+type Customer struct {
+	ID           int64          `json:"id"`
+	Guid         string         `json:"guid"`
+	CustomerCode string         `json:"customer_code"`
+	Name         string         `json:"name"`
+	ContactName  sql.NullString `json:"contact_name"`
+	Email        sql.NullString `json:"email"`
+	PhoneNumber  sql.NullString `json:"phone_number"`
+	TaxID        sql.NullString `json:"tax_id"`
+	CreatedAt    time.Time      `json:"created_at"`
+	CreatedBy    string         `json:"created_by"`
+	UpdatedAt    sql.NullTime   `json:"updated_at"`
+	UpdatedBy    sql.NullString `json:"updated_by"`
+	DeletedAt    sql.NullTime   `json:"deleted_at"`
+	DeletedBy    sql.NullString `json:"deleted_by"`
+}
+
+type CustomerAddress struct {
+	ID            int64          `json:"id"`
+	Guid          string         `json:"guid"`
+	CustomerGuid  string         `json:"customer_guid"`
+	Label         sql.NullString `json:"label"`
+	RecipientName sql.NullString `json:"recipient_name"`
+	PhoneNumber   sql.NullString `json:"phone_number"`
+	Address       string         `json:"address"`
+	City          sql.NullString `json:"city"`
+	PostalCode    sql.NullString `json:"postal_code"`
+	IsDefault     bool           `json:"is_default"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CreatedBy     string         `json:"created_by"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	UpdatedBy     sql.NullString `json:"updated_by"`
+	DeletedAt     sql.NullTime   `json:"deleted_at"`
+	DeletedBy     sql.NullString `json:"deleted_by"`
+}
+
 type Employee struct {
 	ID                     int64          `json:"id"`
 	Guid                   string         `json:"guid"`
@@ -58,6 +95,50 @@ type Employee struct {
 	LastLogin              sql.NullTime   `json:"last_login"`
 }
 
+type ExportFile struct {
+	ID           int64          `json:"id"`
+	Guid         string         `json:"guid"`
+	ExportType   string         `json:"export_type"`
+	Format       string         `json:"format"`
+	FileName     string         `json:"file_name"`
+	Status       string         `json:"status"`
+	TotalRows    int64          `json:"total_rows"`
+	Content      []byte         `json:"content"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	CreatedAt    time.Time      `json:"created_at"`
+	CreatedBy    string         `json:"created_by"`
+	CompletedAt  sql.NullTime   `json:"completed_at"`
+}
+
+type Job struct {
+	ID          int64           `json:"id"`
+	Guid        string          `json:"guid"`
+	JobType     string          `json:"job_type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+	Attempts    int64           `json:"attempts"`
+	MaxAttempts int64           `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	LastError   sql.NullString  `json:"last_error"`
+	LockedAt    sql.NullTime    `json:"locked_at"`
+	LockedBy    sql.NullString  `json:"locked_by"`
+	CreatedAt   time.Time       `json:"created_at"`
+	CreatedBy   sql.NullString  `json:"created_by"`
+	CompletedAt sql.NullTime    `json:"completed_at"`
+}
+
+type JobSchedule struct {
+	ID             int64        `json:"id"`
+	Guid           string       `json:"guid"`
+	JobType        string       `json:"job_type"`
+	CronExpression string       `json:"cron_expression"`
+	NextRunAt      time.Time    `json:"next_run_at"`
+	LastRunAt      sql.NullTime `json:"last_run_at"`
+	IsActive       bool         `json:"is_active"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      sql.NullTime `json:"updated_at"`
+}
+
 type Product struct {
 	ID                int64          `json:"id"`
 	Guid              string         `json:"guid"`
@@ -70,36 +151,445 @@ type Product struct {
 	UpdatedBy         sql.NullString `json:"updated_by"`
 	DeletedAt         sql.NullTime   `json:"deleted_at"`
 	DeletedBy         sql.NullString `json:"deleted_by"`
+	IsSerialized      bool           `json:"is_serialized"`
+	Sku               sql.NullString `json:"sku"`
+	BaseUnit          string         `json:"base_unit"`
+	CostingMethod     string         `json:"costing_method"`
+}
+
+type ProductAssembly struct {
+	ID             int64          `json:"id"`
+	Guid           string         `json:"guid"`
+	AssemblyNumber string         `json:"assembly_number"`
+	ProductGuid    string         `json:"product_guid"`
+	WarehouseGuid  string         `json:"warehouse_guid"`
+	Operation      string         `json:"operation"`
+	Quantity       int64          `json:"quantity"`
+	Notes          sql.NullString `json:"notes"`
+	CreatedAt      time.Time      `json:"created_at"`
+	CreatedBy      string         `json:"created_by"`
+}
+
+type ProductBarcode struct {
+	ID          int64          `json:"id"`
+	Guid        string         `json:"guid"`
+	ProductGuid string         `json:"product_guid"`
+	Barcode     string         `json:"barcode"`
+	BarcodeType string         `json:"barcode_type"`
+	Unit        sql.NullString `json:"unit"`
+	CreatedAt   time.Time      `json:"created_at"`
+	CreatedBy   string         `json:"created_by"`
 }
 
 type ProductCategory struct {
-	ID        int64          `json:"id"`
-	Guid      string         `json:"guid"`
-	Name      string         `json:"name"`
-	CreatedAt time.Time      `json:"created_at"`
-	CreatedBy string         `json:"created_by"`
-	UpdatedAt sql.NullTime   `json:"updated_at"`
-	UpdatedBy sql.NullString `json:"updated_by"`
-	DeletedAt sql.NullTime   `json:"deleted_at"`
-	DeletedBy sql.NullString `json:"deleted_by"`
+	ID         int64          `json:"id"`
+	Guid       string         `json:"guid"`
+	Name       string         `json:"name"`
+	CreatedAt  time.Time      `json:"created_at"`
+	CreatedBy  string         `json:"created_by"`
+	UpdatedAt  sql.NullTime   `json:"updated_at"`
+	UpdatedBy  sql.NullString `json:"updated_by"`
+	DeletedAt  sql.NullTime   `json:"deleted_at"`
+	DeletedBy  sql.NullString `json:"deleted_by"`
+	ParentGuid sql.NullString `json:"parent_guid"`
+}
+
+type ProductCategoryLink struct {
+	ID                  int64     `json:"id"`
+	ProductGuid         string    `json:"product_guid"`
+	ProductCategoryGuid string    `json:"product_category_guid"`
+	CreatedAt           time.Time `json:"created_at"`
+	CreatedBy           string    `json:"created_by"`
+}
+
+type ProductComponent struct {
+	ID                   int64     `json:"id"`
+	Guid                 string    `json:"guid"`
+	ProductGuid          string    `json:"product_guid"`
+	ComponentProductGuid string    `json:"component_product_guid"`
+	Quantity             int64     `json:"quantity"`
+	CreatedAt            time.Time `json:"created_at"`
+	CreatedBy            string    `json:"created_by"`
+}
+
+type ProductSerial struct {
+	ID            int64          `json:"id"`
+	Guid          string         `json:"guid"`
+	ProductGuid   string         `json:"product_guid"`
+	SerialNumber  string         `json:"serial_number"`
+	WarehouseGuid sql.NullString `json:"warehouse_guid"`
+	Status        string         `json:"status"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+}
+
+type ProductSerialHistory struct {
+	ID                 int64     `json:"id"`
+	SerialNumber       string    `json:"serial_number"`
+	ProductHistoryGuid string    `json:"product_history_guid"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type ProductSupplier struct {
+	ID           int64          `json:"id"`
+	Guid         string         `json:"guid"`
+	ProductGuid  string         `json:"product_guid"`
+	SupplierGuid string         `json:"supplier_guid"`
+	SupplierSku  sql.NullString `json:"supplier_sku"`
+	LeadTimeDays int32          `json:"lead_time_days"`
+	CreatedAt    time.Time      `json:"created_at"`
+	CreatedBy    string         `json:"created_by"`
+	UpdatedAt    sql.NullTime   `json:"updated_at"`
+	UpdatedBy    sql.NullString `json:"updated_by"`
+}
+
+type ProductUnit struct {
+	ID               int64     `json:"id"`
+	Guid             string    `json:"guid"`
+	ProductGuid      string    `json:"product_guid"`
+	Unit             string    `json:"unit"`
+	ConversionFactor int64     `json:"conversion_factor"`
+	CreatedAt        time.Time `json:"created_at"`
+	CreatedBy        string    `json:"created_by"`
 }
 
 type ProductsHistory struct {
+	ID                  int64          `json:"id"`
+	Guid                string         `json:"guid"`
+	ProductGuid         string         `json:"product_guid"`
+	Quantity            int64          `json:"quantity"`
+	WarehouseGuid       string         `json:"warehouse_guid"`
+	TglMasuk            time.Time      `json:"tgl_masuk"`
+	PegawaiMasuk        string         `json:"pegawai_masuk"`
+	TglKeluar           time.Time      `json:"tgl_keluar"`
+	PegawaiKeluar       string         `json:"pegawai_keluar"`
+	CreatedAt           time.Time      `json:"created_at"`
+	CreatedBy           string         `json:"created_by"`
+	UpdatedAt           sql.NullTime   `json:"updated_at"`
+	UpdatedBy           sql.NullString `json:"updated_by"`
+	DeletedAt           sql.NullTime   `json:"deleted_at"`
+	DeletedBy           sql.NullString `json:"deleted_by"`
+	HistoryType         string         `json:"history_type"`
+	ReferenceType       sql.NullString `json:"reference_type"`
+	ReferenceGuid       sql.NullString `json:"reference_guid"`
+	BinGuid             sql.NullString `json:"bin_guid"`
+	LotNumber           sql.NullString `json:"lot_number"`
+	ExpiryDate          sql.NullTime   `json:"expiry_date"`
+	Unit                sql.NullString `json:"unit"`
+	UnitQuantity        sql.NullInt64  `json:"unit_quantity"`
+	UnitCost            sql.NullInt64  `json:"unit_cost"`
+	TotalCost           sql.NullInt64  `json:"total_cost"`
+	CustomerGuid        sql.NullString `json:"customer_guid"`
+	CustomerAddressGuid sql.NullString `json:"customer_address_guid"`
+	ReasonCode          sql.NullString `json:"reason_code"`
+	StockStatus         string         `json:"stock_status"`
+}
+
+type PurchaseOrder struct {
+	ID            int64          `json:"id"`
+	Guid          string         `json:"guid"`
+	PoNumber      string         `json:"po_number"`
+	SupplierName  string         `json:"supplier_name"`
+	WarehouseGuid string         `json:"warehouse_guid"`
+	ExpectedDate  time.Time      `json:"expected_date"`
+	Status        string         `json:"status"`
+	Notes         sql.NullString `json:"notes"`
+	ClosedAt      sql.NullTime   `json:"closed_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CreatedBy     string         `json:"created_by"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	UpdatedBy     sql.NullString `json:"updated_by"`
+}
+
+type PurchaseOrderItem struct {
+	ID                int64         `json:"id"`
+	Guid              string        `json:"guid"`
+	PurchaseOrderGuid string        `json:"purchase_order_guid"`
+	ProductGuid       string        `json:"product_guid"`
+	QuantityOrdered   int64         `json:"quantity_ordered"`
+	QuantityReceived  int64         `json:"quantity_received"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         sql.NullTime  `json:"updated_at"`
+	UnitCost          sql.NullInt64 `json:"unit_cost"`
+}
+
+type SalesOrder struct {
+	ID                  int64          `json:"id"`
+	Guid                string         `json:"guid"`
+	OrderNumber         string         `json:"order_number"`
+	CustomerName        string         `json:"customer_name"`
+	ShippingAddress     sql.NullString `json:"shipping_address"`
+	Status              string         `json:"status"`
+	Notes               sql.NullString `json:"notes"`
+	ClosedAt            sql.NullTime   `json:"closed_at"`
+	CreatedAt           time.Time      `json:"created_at"`
+	CreatedBy           string         `json:"created_by"`
+	UpdatedAt           sql.NullTime   `json:"updated_at"`
+	UpdatedBy           sql.NullString `json:"updated_by"`
+	CustomerGuid        sql.NullString `json:"customer_guid"`
+	CustomerAddressGuid sql.NullString `json:"customer_address_guid"`
+}
+
+type SalesOrderItem struct {
+	ID              int64        `json:"id"`
+	Guid            string       `json:"guid"`
+	SalesOrderGuid  string       `json:"sales_order_guid"`
+	ProductGuid     string       `json:"product_guid"`
+	WarehouseGuid   string       `json:"warehouse_guid"`
+	QuantityOrdered int64        `json:"quantity_ordered"`
+	QuantityShipped int64        `json:"quantity_shipped"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       sql.NullTime `json:"updated_at"`
+}
+
+type StockAlert struct {
+	ID                int64          `json:"id"`
+	Guid              string         `json:"guid"`
+	ProductGuid       string         `json:"product_guid"`
+	WarehouseGuid     string         `json:"warehouse_guid"`
+	AlertType         string         `json:"alert_type"`
+	OnHandQuantity    int64          `json:"on_hand_quantity"`
+	ReorderPoint      int64          `json:"reorder_point"`
+	SuggestedQuantity int64          `json:"suggested_quantity"`
+	Status            string         `json:"status"`
+	NotifiedAt        sql.NullTime   `json:"notified_at"`
+	AcknowledgedAt    sql.NullTime   `json:"acknowledged_at"`
+	AcknowledgedBy    sql.NullString `json:"acknowledged_by"`
+	ResolvedAt        sql.NullTime   `json:"resolved_at"`
+	CreatedAt         time.Time      `json:"created_at"`
+}
+
+type StockBalance struct {
+	ID            int64        `json:"id"`
+	ProductGuid   string       `json:"product_guid"`
+	WarehouseGuid string       `json:"warehouse_guid"`
+	Quantity      int64        `json:"quantity"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type StockCostLayer struct {
+	ID                  int64     `json:"id"`
+	Guid                string    `json:"guid"`
+	ProductGuid         string    `json:"product_guid"`
+	WarehouseGuid       string    `json:"warehouse_guid"`
+	ProductsHistoryGuid string    `json:"products_history_guid"`
+	Quantity            int64     `json:"quantity"`
+	RemainingQuantity   int64     `json:"remaining_quantity"`
+	TotalCost           int64     `json:"total_cost"`
+	RemainingCost       int64     `json:"remaining_cost"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+type StockLocationBalance struct {
+	ID            int64        `json:"id"`
+	ProductGuid   string       `json:"product_guid"`
+	WarehouseGuid string       `json:"warehouse_guid"`
+	BinGuid       string       `json:"bin_guid"`
+	Quantity      int64        `json:"quantity"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type StockLotBalance struct {
+	ID            int64        `json:"id"`
+	ProductGuid   string       `json:"product_guid"`
+	WarehouseGuid string       `json:"warehouse_guid"`
+	LotNumber     string       `json:"lot_number"`
+	ExpiryDate    sql.NullTime `json:"expiry_date"`
+	Quantity      int64        `json:"quantity"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type StockOpname struct {
+	ID            int64          `json:"id"`
+	Guid          string         `json:"guid"`
+	OpnameCode    string         `json:"opname_code"`
+	WarehouseGuid string         `json:"warehouse_guid"`
+	Status        string         `json:"status"`
+	Notes         sql.NullString `json:"notes"`
+	ApprovedAt    sql.NullTime   `json:"approved_at"`
+	ApprovedBy    sql.NullString `json:"approved_by"`
+	CancelledAt   sql.NullTime   `json:"cancelled_at"`
+	CancelledBy   sql.NullString `json:"cancelled_by"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CreatedBy     string         `json:"created_by"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	UpdatedBy     sql.NullString `json:"updated_by"`
+}
+
+type StockOpnameItem struct {
+	ID               int64         `json:"id"`
+	Guid             string        `json:"guid"`
+	StockOpnameGuid  string        `json:"stock_opname_guid"`
+	ProductGuid      string        `json:"product_guid"`
+	CountedQuantity  int64         `json:"counted_quantity"`
+	ExpectedQuantity sql.NullInt64 `json:"expected_quantity"`
+	CountedAt        time.Time     `json:"counted_at"`
+	CountedBy        string        `json:"counted_by"`
+	CreatedAt        time.Time     `json:"created_at"`
+	UpdatedAt        sql.NullTime  `json:"updated_at"`
+}
+
+type StockReorderPoint struct {
 	ID            int64          `json:"id"`
 	Guid          string         `json:"guid"`
 	ProductGuid   string         `json:"product_guid"`
-	Quantity      int64          `json:"quantity"`
 	WarehouseGuid string         `json:"warehouse_guid"`
-	TglMasuk      time.Time      `json:"tgl_masuk"`
-	PegawaiMasuk  string         `json:"pegawai_masuk"`
-	TglKeluar     time.Time      `json:"tgl_keluar"`
-	PegawaiKeluar string         `json:"pegawai_keluar"`
+	MinQuantity   int64          `json:"min_quantity"`
+	MaxQuantity   int64          `json:"max_quantity"`
+	ReorderPoint  int64          `json:"reorder_point"`
 	CreatedAt     time.Time      `json:"created_at"`
 	CreatedBy     string         `json:"created_by"`
 	UpdatedAt     sql.NullTime   `json:"updated_at"`
 	UpdatedBy     sql.NullString `json:"updated_by"`
-	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	DeletedBy     sql.NullString `json:"deleted_by"`
+}
+
+type StockReservation struct {
+	ID                int64        `json:"id"`
+	Guid              string       `json:"guid"`
+	ProductGuid       string       `json:"product_guid"`
+	WarehouseGuid     string       `json:"warehouse_guid"`
+	Quantity          int64        `json:"quantity"`
+	ReferenceType     string       `json:"reference_type"`
+	ReferenceGuid     string       `json:"reference_guid"`
+	ReferenceItemGuid string       `json:"reference_item_guid"`
+	Status            string       `json:"status"`
+	ExpiresAt         sql.NullTime `json:"expires_at"`
+	CreatedAt         time.Time    `json:"created_at"`
+	CreatedBy         string       `json:"created_by"`
+	UpdatedAt         sql.NullTime `json:"updated_at"`
+}
+
+type StockReturn struct {
+	ID                  int64          `json:"id"`
+	Guid                string         `json:"guid"`
+	ReturnNumber        string         `json:"return_number"`
+	ProductsHistoryGuid string         `json:"products_history_guid"`
+	ProductGuid         string         `json:"product_guid"`
+	WarehouseGuid       string         `json:"warehouse_guid"`
+	CustomerGuid        sql.NullString `json:"customer_guid"`
+	Quantity            int64          `json:"quantity"`
+	ReasonCode          string         `json:"reason_code"`
+	Status              string         `json:"status"`
+	Notes               sql.NullString `json:"notes"`
+	ReceivedAt          sql.NullTime   `json:"received_at"`
+	ReceivedBy          sql.NullString `json:"received_by"`
+	InspectedAt         sql.NullTime   `json:"inspected_at"`
+	InspectedBy         sql.NullString `json:"inspected_by"`
+	CancelledAt         sql.NullTime   `json:"cancelled_at"`
+	CancelledBy         sql.NullString `json:"cancelled_by"`
+	CreatedAt           time.Time      `json:"created_at"`
+	CreatedBy           string         `json:"created_by"`
+	UpdatedAt           sql.NullTime   `json:"updated_at"`
+	UpdatedBy           sql.NullString `json:"updated_by"`
+}
+
+type StockReturnOutcome struct {
+	ID                  int64          `json:"id"`
+	Guid                string         `json:"guid"`
+	StockReturnGuid     string         `json:"stock_return_guid"`
+	Disposition         string         `json:"disposition"`
+	Quantity            int64          `json:"quantity"`
+	ReasonCode          sql.NullString `json:"reason_code"`
+	BinGuid             sql.NullString `json:"bin_guid"`
+	ProductsHistoryGuid sql.NullString `json:"products_history_guid"`
+	Notes               sql.NullString `json:"notes"`
+	CreatedAt           time.Time      `json:"created_at"`
+	CreatedBy           string         `json:"created_by"`
+}
+
+type StockSnapshot struct {
+	ID            int64     `json:"id"`
+	SnapshotDate  time.Time `json:"snapshot_date"`
+	ProductGuid   string    `json:"product_guid"`
+	WarehouseGuid string    `json:"warehouse_guid"`
+	Quantity      int64     `json:"quantity"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type StockStatusBalance struct {
+	ID            int64        `json:"id"`
+	ProductGuid   string       `json:"product_guid"`
+	WarehouseGuid string       `json:"warehouse_guid"`
+	StockStatus   string       `json:"stock_status"`
+	Quantity      int64        `json:"quantity"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type StockStatusMove struct {
+	ID            int64          `json:"id"`
+	Guid          string         `json:"guid"`
+	ProductGuid   string         `json:"product_guid"`
+	WarehouseGuid string         `json:"warehouse_guid"`
+	FromStatus    string         `json:"from_status"`
+	ToStatus      string         `json:"to_status"`
+	Quantity      int64          `json:"quantity"`
+	ReasonCode    string         `json:"reason_code"`
+	Notes         sql.NullString `json:"notes"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CreatedBy     string         `json:"created_by"`
+}
+
+type StockTransfer struct {
+	ID                       int64          `json:"id"`
+	Guid                     string         `json:"guid"`
+	TransferCode             string         `json:"transfer_code"`
+	SourceWarehouseGuid      string         `json:"source_warehouse_guid"`
+	DestinationWarehouseGuid string         `json:"destination_warehouse_guid"`
+	Status                   string         `json:"status"`
+	Notes                    sql.NullString `json:"notes"`
+	DispatchedAt             sql.NullTime   `json:"dispatched_at"`
+	DispatchedBy             sql.NullString `json:"dispatched_by"`
+	ReceivedAt               sql.NullTime   `json:"received_at"`
+	ReceivedBy               sql.NullString `json:"received_by"`
+	CancelledAt              sql.NullTime   `json:"cancelled_at"`
+	CancelledBy              sql.NullString `json:"cancelled_by"`
+	CreatedAt                time.Time      `json:"created_at"`
+	CreatedBy                string         `json:"created_by"`
+	UpdatedAt                sql.NullTime   `json:"updated_at"`
+	UpdatedBy                sql.NullString `json:"updated_by"`
+}
+
+type StockTransferItem struct {
+	ID                int64         `json:"id"`
+	Guid              string        `json:"guid"`
+	StockTransferGuid string        `json:"stock_transfer_guid"`
+	ProductGuid       string        `json:"product_guid"`
+	Quantity          int64         `json:"quantity"`
+	ReceivedQuantity  sql.NullInt64 `json:"received_quantity"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         sql.NullTime  `json:"updated_at"`
+}
+
+type StockValuation struct {
+	ID            int64        `json:"id"`
+	ProductGuid   string       `json:"product_guid"`
+	WarehouseGuid string       `json:"warehouse_guid"`
+	Quantity      int64        `json:"quantity"`
+	TotalValue    int64        `json:"total_value"`
+	CreatedAt     time.Time    `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+}
+
+type Supplier struct {
+	ID           int64          `json:"id"`
+	Guid         string         `json:"guid"`
+	SupplierCode string         `json:"supplier_code"`
+	Name         string         `json:"name"`
+	ContactName  sql.NullString `json:"contact_name"`
+	Email        sql.NullString `json:"email"`
+	PhoneNumber  sql.NullString `json:"phone_number"`
+	Address      string         `json:"address"`
+	TaxID        sql.NullString `json:"tax_id"`
+	CreatedAt    time.Time      `json:"created_at"`
+	CreatedBy    string         `json:"created_by"`
+	UpdatedAt    sql.NullTime   `json:"updated_at"`
+	UpdatedBy    sql.NullString `json:"updated_by"`
+	DeletedAt    sql.NullTime   `json:"deleted_at"`
+	DeletedBy    sql.NullString `json:"deleted_by"`
 }
 
 type UserBackoffice struct {
@@ -168,3 +658,19 @@ type Warehouse struct {
 	DeletedAt     sql.NullTime   `json:"deleted_at"`
 	DeletedBy     sql.NullString `json:"deleted_by"`
 }
+
+type WarehouseLocation struct {
+	ID            int64          `json:"id"`
+	Guid          string         `json:"guid"`
+	WarehouseGuid string         `json:"warehouse_guid"`
+	ParentGuid    sql.NullString `json:"parent_guid"`
+	LocationType  string         `json:"location_type"`
+	Code          string         `json:"code"`
+	Name          sql.NullString `json:"name"`
+	CreatedAt     time.Time      `json:"created_at"`
+	CreatedBy     string         `json:"created_by"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	UpdatedBy     sql.NullString `json:"updated_by"`
+	DeletedAt     sql.NullTime   `json:"deleted_at"`
+	DeletedBy     sql.NullString `json:"deleted_by"`
+}
@@ -35,15 +35,35 @@ const getCountProductList = `-- name: GetCountProductList :one
 SELECT COUNT(p.id) FROM product p
 WHERE
     (CASE WHEN $1::bool THEN LOWER(p.name) LIKE LOWER($2) ELSE TRUE END)
+  AND (CASE WHEN $3::bool THEN EXISTS (
+        SELECT 1 FROM product_category_link pcl
+        WHERE pcl.product_guid = p.guid
+          AND (pcl.product_category_guid = $4::varchar OR
+               ($5::bool AND pcl.product_category_guid IN (
+                   WITH RECURSIVE descendant AS (
+                       SELECT child.guid FROM product_category child WHERE child.parent_guid = $4::varchar
+                       UNION ALL
+                       SELECT child.guid FROM product_category child JOIN descendant d ON child.parent_guid = d.guid
+                   ) SELECT descendant.guid FROM descendant)))
+    ) ELSE TRUE END)
 `
 
 type GetCountProductListParams struct {
-	SetName bool   `json:"set_name"`
-	Name    string `json:"name"`
+	SetName            bool   `json:"set_name"`
+	Name               string `json:"name"`
+	SetCategory        bool   `json:"set_category"`
+	CategoryGuid       string `json:"category_guid"`
+	IncludeSubCategory bool   `json:"include_sub_category"`
 }
 
 func (q *Queries) GetCountProductList(ctx context.Context, arg GetCountProductListParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, getCountProductList, arg.SetName, arg.Name)
+	row := q.db.QueryRowContext(ctx, getCountProductList,
+		arg.SetName,
+		arg.Name,
+		arg.SetCategory,
+		arg.CategoryGuid,
+		arg.IncludeSubCategory,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -52,7 +72,7 @@ func (q *Queries) GetCountProductList(ctx context.Context, arg GetCountProductLi
 const getProduct = `-- name: GetProduct :one
 SELECT
     p.guid, p.name, p.product_picture_url, p.description, p.created_at, p.created_by,
-    p.updated_at, p.updated_by, p.deleted_at, p.deleted_by,
+    p.updated_at, p.updated_by, p.deleted_at, p.deleted_by, p.is_serialized, p.sku, p.base_unit, p.costing_method,
     ub_created.name AS user_name, ub_created.guid AS user_id,
     ub_updated.name AS user_name_update, ub_updated.guid AS user_id_update
 FROM
@@ -74,6 +94,10 @@ type GetProductRow struct {
 	UpdatedBy         sql.NullString `json:"updated_by"`
 	DeletedAt         sql.NullTime   `json:"deleted_at"`
 	DeletedBy         sql.NullString `json:"deleted_by"`
+	IsSerialized      bool           `json:"is_serialized"`
+	Sku               sql.NullString `json:"sku"`
+	BaseUnit          string         `json:"base_unit"`
+	CostingMethod     string         `json:"costing_method"`
 	UserName          sql.NullString `json:"user_name"`
 	UserID            sql.NullString `json:"user_id"`
 	UserNameUpdate    sql.NullString `json:"user_name_update"`
@@ -94,6 +118,10 @@ func (q *Queries) GetProduct(ctx context.Context, guid string) (GetProductRow, e
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.IsSerialized,
+		&i.Sku,
+		&i.BaseUnit,
+		&i.CostingMethod,
 		&i.UserName,
 		&i.UserID,
 		&i.UserNameUpdate,
@@ -102,12 +130,25 @@ func (q *Queries) GetProduct(ctx context.Context, guid string) (GetProductRow, e
 	return i, err
 }
 
+const getProductGuidBySku = `-- name: GetProductGuidBySku :one
+SELECT guid FROM product
+WHERE
+    sku = $1
+`
+
+func (q *Queries) GetProductGuidBySku(ctx context.Context, sku sql.NullString) (string, error) {
+	row := q.db.QueryRowContext(ctx, getProductGuidBySku, sku)
+	var guid string
+	err := row.Scan(&guid)
+	return guid, err
+}
+
 const insertProduct = `-- name: InsertProduct :one
 INSERT INTO product 
-        (guid, name, product_picture_url, description, created_at, created_by)
+        (guid, name, product_picture_url, description, is_serialized, sku, base_unit, costing_method, created_at, created_by)
     VALUES
-        ($1, $2, $3, $4, (now() at time zone 'UTC')::TIMESTAMP, $5)
-RETURNING product.id, product.guid, product.name, product.product_picture_url, product.description, product.created_at, product.created_by, product.updated_at, product.updated_by, product.deleted_at, product.deleted_by
+        ($1, $2, $3, $4, $5, $6, $7, $8, (now() at time zone 'UTC')::TIMESTAMP, $9)
+RETURNING product.id, product.guid, product.name, product.product_picture_url, product.description, product.created_at, product.created_by, product.updated_at, product.updated_by, product.deleted_at, product.deleted_by, product.is_serialized, product.sku, product.base_unit, product.costing_method
 `
 
 type InsertProductParams struct {
@@ -115,6 +156,10 @@ type InsertProductParams struct {
 	Name              sql.NullString `json:"name"`
 	ProductPictureUrl sql.NullString `json:"product_picture_url"`
 	Description       string         `json:"description"`
+	IsSerialized      bool           `json:"is_serialized"`
+	Sku               sql.NullString `json:"sku"`
+	BaseUnit          string         `json:"base_unit"`
+	CostingMethod     string         `json:"costing_method"`
 	CreatedBy         string         `json:"created_by"`
 }
 
@@ -124,6 +169,10 @@ func (q *Queries) InsertProduct(ctx context.Context, arg InsertProductParams) (P
 		arg.Name,
 		arg.ProductPictureUrl,
 		arg.Description,
+		arg.IsSerialized,
+		arg.Sku,
+		arg.BaseUnit,
+		arg.CostingMethod,
 		arg.CreatedBy,
 	)
 	var i Product
@@ -139,13 +188,17 @@ func (q *Queries) InsertProduct(ctx context.Context, arg InsertProductParams) (P
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.IsSerialized,
+		&i.Sku,
+		&i.BaseUnit,
+		&i.CostingMethod,
 	)
 	return i, err
 }
 
 const listProduct = `-- name: ListProduct :many
 SELECT
-    p.guid, p.name, p.product_picture_url, p.description, p.created_at, p.created_by, p.updated_at, p.updated_by, p.deleted_at, p.deleted_by,
+    p.guid, p.name, p.product_picture_url, p.description, p.created_at, p.created_by, p.updated_at, p.updated_by, p.deleted_at, p.deleted_by, p.is_serialized, p.sku, p.base_unit, p.costing_method,
     ub_created.name AS user_name, ub_created.guid AS user_id,
     ub_updated.name AS user_name_update, ub_updated.guid AS user_id_update
 FROM
@@ -154,24 +207,38 @@ FROM
         LEFT JOIN user_backoffice ub_updated ON ub_updated.guid = p.updated_by
 WHERE
     (CASE WHEN $1::bool THEN LOWER(p.name) LIKE LOWER($2) ELSE TRUE END)
+  AND (CASE WHEN $3::bool THEN EXISTS (
+        SELECT 1 FROM product_category_link pcl
+        WHERE pcl.product_guid = p.guid
+          AND (pcl.product_category_guid = $4::varchar OR
+               ($5::bool AND pcl.product_category_guid IN (
+                   WITH RECURSIVE descendant AS (
+                       SELECT child.guid FROM product_category child WHERE child.parent_guid = $4::varchar
+                       UNION ALL
+                       SELECT child.guid FROM product_category child JOIN descendant d ON child.parent_guid = d.guid
+                   ) SELECT descendant.guid FROM descendant)))
+    ) ELSE TRUE END)
 ORDER BY
-    (CASE WHEN $3 = 'id ASC' THEN p.guid END) ASC,
-    (CASE WHEN $3 = 'id DESC' THEN p.guid END) DESC,
-    (CASE WHEN $3 = 'name ASC' THEN p.name END) ASC,
-    (CASE WHEN $3 = 'name DESC' THEN p.name END) DESC,
-    (CASE WHEN $3 = 'created_at ASC' THEN p.created_at END) ASC,
-    (CASE WHEN $3 = 'created_at DESC' THEN p.created_at END) DESC,
+    (CASE WHEN $6 = 'id ASC' THEN p.guid END) ASC,
+    (CASE WHEN $6 = 'id DESC' THEN p.guid END) DESC,
+    (CASE WHEN $6 = 'name ASC' THEN p.name END) ASC,
+    (CASE WHEN $6 = 'name DESC' THEN p.name END) DESC,
+    (CASE WHEN $6 = 'created_at ASC' THEN p.created_at END) ASC,
+    (CASE WHEN $6 = 'created_at DESC' THEN p.created_at END) DESC,
     p.created_at DESC
-    LIMIT $5
-OFFSET $4
+    LIMIT $8
+OFFSET $7
 `
 
 type ListProductParams struct {
-	SetName    bool        `json:"set_name"`
-	Name       string      `json:"name"`
-	OrderParam interface{} `json:"order_param"`
-	OffsetPage int32       `json:"offset_page"`
-	LimitData  int32       `json:"limit_data"`
+	SetName            bool        `json:"set_name"`
+	Name               string      `json:"name"`
+	SetCategory        bool        `json:"set_category"`
+	CategoryGuid       string      `json:"category_guid"`
+	IncludeSubCategory bool        `json:"include_sub_category"`
+	OrderParam         interface{} `json:"order_param"`
+	OffsetPage         int32       `json:"offset_page"`
+	LimitData          int32       `json:"limit_data"`
 }
 
 type ListProductRow struct {
@@ -185,6 +252,10 @@ type ListProductRow struct {
 	UpdatedBy         sql.NullString `json:"updated_by"`
 	DeletedAt         sql.NullTime   `json:"deleted_at"`
 	DeletedBy         sql.NullString `json:"deleted_by"`
+	IsSerialized      bool           `json:"is_serialized"`
+	Sku               sql.NullString `json:"sku"`
+	BaseUnit          string         `json:"base_unit"`
+	CostingMethod     string         `json:"costing_method"`
 	UserName          sql.NullString `json:"user_name"`
 	UserID            sql.NullString `json:"user_id"`
 	UserNameUpdate    sql.NullString `json:"user_name_update"`
@@ -195,6 +266,9 @@ func (q *Queries) ListProduct(ctx context.Context, arg ListProductParams) ([]Lis
 	rows, err := q.db.QueryContext(ctx, listProduct,
 		arg.SetName,
 		arg.Name,
+		arg.SetCategory,
+		arg.CategoryGuid,
+		arg.IncludeSubCategory,
 		arg.OrderParam,
 		arg.OffsetPage,
 		arg.LimitData,
@@ -217,6 +291,10 @@ func (q *Queries) ListProduct(ctx context.Context, arg ListProductParams) ([]Lis
 			&i.UpdatedBy,
 			&i.DeletedAt,
 			&i.DeletedBy,
+			&i.IsSerialized,
+			&i.Sku,
+			&i.BaseUnit,
+			&i.CostingMethod,
 			&i.UserName,
 			&i.UserID,
 			&i.UserNameUpdate,
@@ -262,16 +340,24 @@ UPDATE product
 SET name = $1,
     product_picture_url = $2,
     description = $3,
-    updated_by = $4,
+    is_serialized = $4,
+    sku = $5,
+    base_unit = $6,
+    costing_method = $7,
+    updated_by = $8,
     updated_at = (now() at time zone 'UTC')::TIMESTAMP 
-WHERE guid = $5
-RETURNING product.id, product.guid, product.name, product.product_picture_url, product.description, product.created_at, product.created_by, product.updated_at, product.updated_by, product.deleted_at, product.deleted_by
+WHERE guid = $9
+RETURNING product.id, product.guid, product.name, product.product_picture_url, product.description, product.created_at, product.created_by, product.updated_at, product.updated_by, product.deleted_at, product.deleted_by, product.is_serialized, product.sku, product.base_unit, product.costing_method
 `
 
 type UpdateProductParams struct {
 	Name              sql.NullString `json:"name"`
 	ProductPictureUrl sql.NullString `json:"product_picture_url"`
 	Description       string         `json:"description"`
+	IsSerialized      bool           `json:"is_serialized"`
+	Sku               sql.NullString `json:"sku"`
+	BaseUnit          string         `json:"base_unit"`
+	CostingMethod     string         `json:"costing_method"`
 	UpdatedBy         sql.NullString `json:"updated_by"`
 	Guid              string         `json:"guid"`
 }
@@ -281,6 +367,10 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		arg.Name,
 		arg.ProductPictureUrl,
 		arg.Description,
+		arg.IsSerialized,
+		arg.Sku,
+		arg.BaseUnit,
+		arg.CostingMethod,
 		arg.UpdatedBy,
 		arg.Guid,
 	)
@@ -297,6 +387,10 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) (P
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.IsSerialized,
+		&i.Sku,
+		&i.BaseUnit,
+		&i.CostingMethod,
 	)
 	return i, err
 }
@@ -34,6 +34,41 @@ func (q *Queries) DeleteProductCategory(ctx context.Context, arg DeleteProductCa
 	return err
 }
 
+const getCountActiveChildProductCategory = `-- name: GetCountActiveChildProductCategory :one
+SELECT
+    count(pc.id) AS total_data
+FROM
+    product_category pc
+WHERE
+    pc.parent_guid = $1
+  AND pc.deleted_at IS NULL
+`
+
+func (q *Queries) GetCountActiveChildProductCategory(ctx context.Context, parentGuid sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getCountActiveChildProductCategory, parentGuid)
+	var total_data int64
+	err := row.Scan(&total_data)
+	return total_data, err
+}
+
+const getCountActiveProductByCategory = `-- name: GetCountActiveProductByCategory :one
+SELECT
+    count(p.id) AS total_data
+FROM
+    product_category_link pcl
+        JOIN product p ON p.guid = pcl.product_guid
+WHERE
+    pcl.product_category_guid = $1
+  AND p.deleted_at IS NULL
+`
+
+func (q *Queries) GetCountActiveProductByCategory(ctx context.Context, productCategoryGuid string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getCountActiveProductByCategory, productCategoryGuid)
+	var total_data int64
+	err := row.Scan(&total_data)
+	return total_data, err
+}
+
 const getCountListProductCategory = `-- name: GetCountListProductCategory :one
 SELECT
     count(pc.id) AS total_data
@@ -45,14 +80,25 @@ WHERE
                 (pc.deleted_at IS NULL AND $4 = 'active') OR
                 (pc.deleted_at IS NOT NULL AND $4 = 'inactive')
             ELSE TRUE END)
+  AND (CASE WHEN $5::bool THEN
+                pc.parent_guid = $6::varchar OR
+                ($7::bool AND pc.guid IN (WITH RECURSIVE descendant AS (
+        SELECT child.guid FROM product_category child WHERE child.parent_guid = $6::varchar
+        UNION ALL
+        SELECT child.guid FROM product_category child JOIN descendant d ON child.parent_guid = d.guid
+    ) SELECT descendant.guid FROM descendant))
+            ELSE TRUE END)
   AND pc.deleted_at IS NULL
 `
 
 type GetCountListProductCategoryParams struct {
-	SetName   bool        `json:"set_name"`
-	Name      string      `json:"name"`
-	SetActive bool        `json:"set_active"`
-	Active    interface{} `json:"active"`
+	SetName            bool        `json:"set_name"`
+	Name               string      `json:"name"`
+	SetActive          bool        `json:"set_active"`
+	Active             interface{} `json:"active"`
+	SetParent          bool        `json:"set_parent"`
+	ParentGuid         string      `json:"parent_guid"`
+	IncludeDescendants bool        `json:"include_descendants"`
 }
 
 func (q *Queries) GetCountListProductCategory(ctx context.Context, arg GetCountListProductCategoryParams) (int64, error) {
@@ -61,6 +107,9 @@ func (q *Queries) GetCountListProductCategory(ctx context.Context, arg GetCountL
 		arg.Name,
 		arg.SetActive,
 		arg.Active,
+		arg.SetParent,
+		arg.ParentGuid,
+		arg.IncludeDescendants,
 	)
 	var total_data int64
 	err := row.Scan(&total_data)
@@ -70,7 +119,7 @@ func (q *Queries) GetCountListProductCategory(ctx context.Context, arg GetCountL
 const getProductCategory = `-- name: GetProductCategory :one
 SELECT
     pc.guid, pc.name, pc.created_at, pc.created_by,
-    pc.updated_at, pc.updated_by, pc.deleted_at, pc.deleted_by,
+    pc.updated_at, pc.updated_by, pc.deleted_at, pc.deleted_by, pc.parent_guid,
     ub_created.name AS user_name, ub_created.guid AS user_id,
     ub_updated.name AS user_name_update, ub_updated.guid AS user_id_update,
     ub_deleted.name AS user_name_delete, ub_deleted.guid AS user_id_delete
@@ -92,6 +141,7 @@ type GetProductCategoryRow struct {
 	UpdatedBy      sql.NullString `json:"updated_by"`
 	DeletedAt      sql.NullTime   `json:"deleted_at"`
 	DeletedBy      sql.NullString `json:"deleted_by"`
+	ParentGuid     sql.NullString `json:"parent_guid"`
 	UserName       sql.NullString `json:"user_name"`
 	UserID         sql.NullString `json:"user_id"`
 	UserNameUpdate sql.NullString `json:"user_name_update"`
@@ -112,6 +162,7 @@ func (q *Queries) GetProductCategory(ctx context.Context, guid string) (GetProdu
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.ParentGuid,
 		&i.UserName,
 		&i.UserID,
 		&i.UserNameUpdate,
@@ -124,20 +175,26 @@ func (q *Queries) GetProductCategory(ctx context.Context, guid string) (GetProdu
 
 const insertProductCategory = `-- name: InsertProductCategory :one
 INSERT INTO product_category
-(guid, name, created_at, created_by)
+(guid, name, parent_guid, created_at, created_by)
 VALUES
-    ($1, $2, (now() at time zone 'UTC')::TIMESTAMP, $3)
-RETURNING product_category.id, product_category.guid, product_category.name, product_category.created_at, product_category.created_by, product_category.updated_at, product_category.updated_by, product_category.deleted_at, product_category.deleted_by
+    ($1, $2, $3, (now() at time zone 'UTC')::TIMESTAMP, $4)
+RETURNING product_category.id, product_category.guid, product_category.name, product_category.created_at, product_category.created_by, product_category.updated_at, product_category.updated_by, product_category.deleted_at, product_category.deleted_by, product_category.parent_guid
 `
 
 type InsertProductCategoryParams struct {
-	Guid      string `json:"guid"`
-	Name      string `json:"name"`
-	CreatedBy string `json:"created_by"`
+	Guid       string         `json:"guid"`
+	Name       string         `json:"name"`
+	ParentGuid sql.NullString `json:"parent_guid"`
+	CreatedBy  string         `json:"created_by"`
 }
 
 func (q *Queries) InsertProductCategory(ctx context.Context, arg InsertProductCategoryParams) (ProductCategory, error) {
-	row := q.db.QueryRowContext(ctx, insertProductCategory, arg.Guid, arg.Name, arg.CreatedBy)
+	row := q.db.QueryRowContext(ctx, insertProductCategory,
+		arg.Guid,
+		arg.Name,
+		arg.ParentGuid,
+		arg.CreatedBy,
+	)
 	var i ProductCategory
 	err := row.Scan(
 		&i.ID,
@@ -149,6 +206,7 @@ func (q *Queries) InsertProductCategory(ctx context.Context, arg InsertProductCa
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.ParentGuid,
 	)
 	return i, err
 }
@@ -156,7 +214,7 @@ func (q *Queries) InsertProductCategory(ctx context.Context, arg InsertProductCa
 const listProductCategory = `-- name: ListProductCategory :many
 SELECT
     pc.guid, pc.name, pc.created_at, pc.created_by,
-    pc.updated_at, pc.updated_by, pc.deleted_at, pc.deleted_by,
+    pc.updated_at, pc.updated_by, pc.deleted_at, pc.deleted_by, pc.parent_guid,
     ub_created.name AS user_name, ub_created.guid AS user_id,
     ub_updated.name AS user_name_update, ub_updated.guid AS user_id_update,
     ub_deleted.name AS user_name_delete, ub_deleted.guid AS user_id_delete
@@ -171,25 +229,36 @@ WHERE
                 (pc.deleted_at IS NULL AND $4 = 'active') OR
                 (pc.deleted_at IS NOT NULL AND $4 = 'inactive')
             ELSE TRUE END)
-ORDER BY (CASE WHEN $5 = 'id ASC' THEN pc.guid END) ASC,
-         (CASE WHEN $5 = 'id DESC' THEN pc.guid END) DESC,
-         (CASE WHEN $5 = 'name ASC' THEN pc.name END) ASC,
-         (CASE WHEN $5 = 'name DESC' THEN pc.name END) DESC,
-         (CASE WHEN $5 = 'created_at ASC' THEN pc.created_at END) ASC,
-         (CASE WHEN $5 = 'created_at DESC' THEN pc.created_at END) DESC,
+  AND (CASE WHEN $5::bool THEN
+                pc.parent_guid = $6::varchar OR
+                ($7::bool AND pc.guid IN (WITH RECURSIVE descendant AS (
+        SELECT child.guid FROM product_category child WHERE child.parent_guid = $6::varchar
+        UNION ALL
+        SELECT child.guid FROM product_category child JOIN descendant d ON child.parent_guid = d.guid
+    ) SELECT descendant.guid FROM descendant))
+            ELSE TRUE END)
+ORDER BY (CASE WHEN $8 = 'id ASC' THEN pc.guid END) ASC,
+         (CASE WHEN $8 = 'id DESC' THEN pc.guid END) DESC,
+         (CASE WHEN $8 = 'name ASC' THEN pc.name END) ASC,
+         (CASE WHEN $8 = 'name DESC' THEN pc.name END) DESC,
+         (CASE WHEN $8 = 'created_at ASC' THEN pc.created_at END) ASC,
+         (CASE WHEN $8 = 'created_at DESC' THEN pc.created_at END) DESC,
          pc.created_at DESC
-LIMIT $7
-    OFFSET $6
+LIMIT $10
+    OFFSET $9
 `
 
 type ListProductCategoryParams struct {
-	SetName    bool        `json:"set_name"`
-	Name       string      `json:"name"`
-	SetActive  bool        `json:"set_active"`
-	Active     interface{} `json:"active"`
-	OrderParam interface{} `json:"order_param"`
-	OffsetPage int32       `json:"offset_page"`
-	LimitData  int32       `json:"limit_data"`
+	SetName            bool        `json:"set_name"`
+	Name               string      `json:"name"`
+	SetActive          bool        `json:"set_active"`
+	Active             interface{} `json:"active"`
+	SetParent          bool        `json:"set_parent"`
+	ParentGuid         string      `json:"parent_guid"`
+	IncludeDescendants bool        `json:"include_descendants"`
+	OrderParam         interface{} `json:"order_param"`
+	OffsetPage         int32       `json:"offset_page"`
+	LimitData          int32       `json:"limit_data"`
 }
 
 type ListProductCategoryRow struct {
@@ -201,6 +270,7 @@ type ListProductCategoryRow struct {
 	UpdatedBy      sql.NullString `json:"updated_by"`
 	DeletedAt      sql.NullTime   `json:"deleted_at"`
 	DeletedBy      sql.NullString `json:"deleted_by"`
+	ParentGuid     sql.NullString `json:"parent_guid"`
 	UserName       sql.NullString `json:"user_name"`
 	UserID         sql.NullString `json:"user_id"`
 	UserNameUpdate sql.NullString `json:"user_name_update"`
@@ -215,6 +285,9 @@ func (q *Queries) ListProductCategory(ctx context.Context, arg ListProductCatego
 		arg.Name,
 		arg.SetActive,
 		arg.Active,
+		arg.SetParent,
+		arg.ParentGuid,
+		arg.IncludeDescendants,
 		arg.OrderParam,
 		arg.OffsetPage,
 		arg.LimitData,
@@ -235,6 +308,7 @@ func (q *Queries) ListProductCategory(ctx context.Context, arg ListProductCatego
 			&i.UpdatedBy,
 			&i.DeletedAt,
 			&i.DeletedBy,
+			&i.ParentGuid,
 			&i.UserName,
 			&i.UserID,
 			&i.UserNameUpdate,
@@ -255,6 +329,89 @@ func (q *Queries) ListProductCategory(ctx context.Context, arg ListProductCatego
 	return items, nil
 }
 
+const listProductCategoryDescendant = `-- name: ListProductCategoryDescendant :many
+WITH RECURSIVE descendant AS (
+        SELECT child.guid FROM product_category child WHERE child.parent_guid = $1
+        UNION ALL
+        SELECT child.guid FROM product_category child JOIN descendant d ON child.parent_guid = d.guid
+    ) SELECT descendant.guid FROM descendant
+`
+
+func (q *Queries) ListProductCategoryDescendant(ctx context.Context, parentGuid sql.NullString) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listProductCategoryDescendant, parentGuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var guid string
+		if err := rows.Scan(&guid); err != nil {
+			return nil, err
+		}
+		items = append(items, guid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductCategoryTree = `-- name: ListProductCategoryTree :many
+SELECT
+    pc.guid, pc.parent_guid, pc.name
+FROM
+    product_category pc
+WHERE
+    pc.deleted_at IS NULL
+ORDER BY pc.name ASC
+`
+
+type ListProductCategoryTreeRow struct {
+	Guid       string         `json:"guid"`
+	ParentGuid sql.NullString `json:"parent_guid"`
+	Name       string         `json:"name"`
+}
+
+func (q *Queries) ListProductCategoryTree(ctx context.Context) ([]ListProductCategoryTreeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProductCategoryTree)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProductCategoryTreeRow
+	for rows.Next() {
+		var i ListProductCategoryTreeRow
+		if err := rows.Scan(
+			&i.Guid,
+			&i.ParentGuid,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockProductCategoryTree = `-- name: LockProductCategoryTree :exec
+SELECT pg_advisory_xact_lock(hashtext('product_category_tree'))
+`
+
+func (q *Queries) LockProductCategoryTree(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, lockProductCategoryTree)
+	return err
+}
+
 const reactiveProductCategory = `-- name: ReactiveProductCategory :exec
 UPDATE product_category
 SET
@@ -286,7 +443,7 @@ SET
 WHERE
     guid = $3
   AND deleted_at IS NULL
-RETURNING product_category.id, product_category.guid, product_category.name, product_category.created_at, product_category.created_by, product_category.updated_at, product_category.updated_by, product_category.deleted_at, product_category.deleted_by
+RETURNING product_category.id, product_category.guid, product_category.name, product_category.created_at, product_category.created_by, product_category.updated_at, product_category.updated_by, product_category.deleted_at, product_category.deleted_by, product_category.parent_guid
 `
 
 type UpdateProductCategoryParams struct {
@@ -308,6 +465,43 @@ func (q *Queries) UpdateProductCategory(ctx context.Context, arg UpdateProductCa
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.ParentGuid,
+	)
+	return i, err
+}
+
+const updateProductCategoryParent = `-- name: UpdateProductCategoryParent :one
+UPDATE product_category
+SET
+    parent_guid = $1,
+    updated_at = (now() at time zone 'UTC')::TIMESTAMP,
+    updated_by = $2
+WHERE
+    guid = $3
+  AND deleted_at IS NULL
+RETURNING product_category.id, product_category.guid, product_category.name, product_category.created_at, product_category.created_by, product_category.updated_at, product_category.updated_by, product_category.deleted_at, product_category.deleted_by, product_category.parent_guid
+`
+
+type UpdateProductCategoryParentParams struct {
+	ParentGuid sql.NullString `json:"parent_guid"`
+	UpdatedBy  sql.NullString `json:"updated_by"`
+	Guid       string         `json:"guid"`
+}
+
+func (q *Queries) UpdateProductCategoryParent(ctx context.Context, arg UpdateProductCategoryParentParams) (ProductCategory, error) {
+	row := q.db.QueryRowContext(ctx, updateProductCategoryParent, arg.ParentGuid, arg.UpdatedBy, arg.Guid)
+	var i ProductCategory
+	err := row.Scan(
+		&i.ID,
+		&i.Guid,
+		&i.Name,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.ParentGuid,
 	)
 	return i, err
 }
@@ -8,6 +8,7 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const deleteProductsHistory = `-- name: DeleteProductsHistory :exec
@@ -44,7 +45,7 @@ func (q *Queries) DeleteProductsHistory(ctx context.Context, arg DeleteProductsH
 }
 
 const findWithGUIDProductsHistory = `-- name: FindWithGUIDProductsHistory :many
-SELECT id, guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, tgl_keluar, pegawai_keluar, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by
+SELECT id, guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, tgl_keluar, pegawai_keluar, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, history_type, reference_type, reference_guid, bin_guid, lot_number, expiry_date, unit, unit_quantity, unit_cost, total_cost, customer_guid, customer_address_guid, reason_code, stock_status
 FROM products_history
 WHERE guid = $1
 `
@@ -74,6 +75,20 @@ func (q *Queries) FindWithGUIDProductsHistory(ctx context.Context, guid string)
 			&i.UpdatedBy,
 			&i.DeletedAt,
 			&i.DeletedBy,
+			&i.HistoryType,
+			&i.ReferenceType,
+			&i.ReferenceGuid,
+			&i.BinGuid,
+			&i.LotNumber,
+			&i.ExpiryDate,
+			&i.Unit,
+			&i.UnitQuantity,
+			&i.UnitCost,
+			&i.TotalCost,
+			&i.CustomerGuid,
+			&i.CustomerAddressGuid,
+			&i.ReasonCode,
+			&i.StockStatus,
 		); err != nil {
 			return nil, err
 		}
@@ -88,21 +103,210 @@ func (q *Queries) FindWithGUIDProductsHistory(ctx context.Context, guid string)
 	return items, nil
 }
 
+const getCountProductHistory = `-- name: GetCountProductHistory :one
+SELECT COUNT(id) FROM products_history
+WHERE
+    (CASE WHEN $1::bool THEN LOWER(pegawai_masuk) LIKE LOWER($2) ELSE TRUE END)
+    AND(CASE WHEN $3::bool THEN LOWER(pegawai_keluar) LIKE LOWER($4) ELSE TRUE END)
+    AND (CASE WHEN $5::bool THEN warehouse_guid = $6 ELSE TRUE END)
+    AND (CASE WHEN $7::bool THEN product_guid = $8 ELSE TRUE END)
+    AND (CASE WHEN $9::bool THEN history_type = $10 ELSE TRUE END)
+    AND (CASE WHEN $11::bool THEN created_at BETWEEN $12 AND $13 ELSE TRUE END)
+    AND (CASE WHEN $14::bool THEN reference_type = $15 AND reference_guid = $16 ELSE TRUE END)
+    AND (CASE WHEN $17::bool THEN customer_guid = $18 ELSE TRUE END)
+    AND (CASE WHEN $19::bool THEN stock_status = $20 ELSE TRUE END)
+    AND deleted_at IS NULL
+`
+
+type GetCountProductHistoryParams struct {
+	SetPegawaiMasuk  bool           `json:"set_pegawai_masuk"`
+	PegawaiMasuk     string         `json:"pegawai_masuk"`
+	SetPegawaiKeluar bool           `json:"set_pegawai_keluar"`
+	PegawaiKeluar    string         `json:"pegawai_keluar"`
+	SetWarehouse     bool           `json:"set_warehouse"`
+	WarehouseGuid    string         `json:"warehouse_guid"`
+	SetProduct       bool           `json:"set_product"`
+	ProductGuid      string         `json:"product_guid"`
+	SetHistoryType   bool           `json:"set_history_type"`
+	HistoryType      string         `json:"history_type"`
+	SetDate          bool           `json:"set_date"`
+	StartDate        time.Time      `json:"start_date"`
+	EndDate          time.Time      `json:"end_date"`
+	SetReference     bool           `json:"set_reference"`
+	ReferenceType    sql.NullString `json:"reference_type"`
+	ReferenceGuid    sql.NullString `json:"reference_guid"`
+	SetCustomer      bool           `json:"set_customer"`
+	CustomerGuid     sql.NullString `json:"customer_guid"`
+	SetStockStatus   bool           `json:"set_stock_status"`
+	StockStatus      string         `json:"stock_status"`
+}
+
+func (q *Queries) GetCountProductHistory(ctx context.Context, arg GetCountProductHistoryParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getCountProductHistory,
+		arg.SetPegawaiMasuk,
+		arg.PegawaiMasuk,
+		arg.SetPegawaiKeluar,
+		arg.PegawaiKeluar,
+		arg.SetWarehouse,
+		arg.WarehouseGuid,
+		arg.SetProduct,
+		arg.ProductGuid,
+		arg.SetHistoryType,
+		arg.HistoryType,
+		arg.SetDate,
+		arg.StartDate,
+		arg.EndDate,
+		arg.SetReference,
+		arg.ReferenceType,
+		arg.ReferenceGuid,
+		arg.SetCustomer,
+		arg.CustomerGuid,
+		arg.SetStockStatus,
+		arg.StockStatus,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getProductsHistoryForUpdate = `-- name: GetProductsHistoryForUpdate :one
+SELECT id, guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, tgl_keluar, pegawai_keluar, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, history_type, reference_type, reference_guid, bin_guid, lot_number, expiry_date, unit, unit_quantity, unit_cost, total_cost, customer_guid, customer_address_guid, reason_code, stock_status
+FROM products_history
+WHERE
+    guid = $1
+  AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetProductsHistoryForUpdate(ctx context.Context, guid string) (ProductsHistory, error) {
+	row := q.db.QueryRowContext(ctx, getProductsHistoryForUpdate, guid)
+	var i ProductsHistory
+	err := row.Scan(
+		&i.ID,
+		&i.Guid,
+		&i.ProductGuid,
+		&i.Quantity,
+		&i.WarehouseGuid,
+		&i.TglMasuk,
+		&i.PegawaiMasuk,
+		&i.TglKeluar,
+		&i.PegawaiKeluar,
+		&i.CreatedAt,
+		&i.CreatedBy,
+		&i.UpdatedAt,
+		&i.UpdatedBy,
+		&i.DeletedAt,
+		&i.DeletedBy,
+		&i.HistoryType,
+		&i.ReferenceType,
+		&i.ReferenceGuid,
+		&i.BinGuid,
+		&i.LotNumber,
+		&i.ExpiryDate,
+		&i.Unit,
+		&i.UnitQuantity,
+		&i.UnitCost,
+		&i.TotalCost,
+		&i.CustomerGuid,
+		&i.CustomerAddressGuid,
+		&i.ReasonCode,
+		&i.StockStatus,
+	)
+	return i, err
+}
+
+const getSumProductsHistoryByReference = `-- name: GetSumProductsHistoryByReference :one
+SELECT
+    COALESCE(SUM(quantity), 0)::bigint AS quantity,
+    COALESCE(SUM(total_cost), 0)::bigint AS total_cost
+FROM products_history
+WHERE
+    reference_type = $1
+  AND reference_guid = $2
+  AND product_guid = $3
+  AND history_type = $4
+  AND deleted_at IS NULL
+`
+
+type GetSumProductsHistoryByReferenceParams struct {
+	ReferenceType sql.NullString `json:"reference_type"`
+	ReferenceGuid sql.NullString `json:"reference_guid"`
+	ProductGuid   string         `json:"product_guid"`
+	HistoryType   string         `json:"history_type"`
+}
+
+type GetSumProductsHistoryByReferenceRow struct {
+	Quantity  int64 `json:"quantity"`
+	TotalCost int64 `json:"total_cost"`
+}
+
+func (q *Queries) GetSumProductsHistoryByReference(ctx context.Context, arg GetSumProductsHistoryByReferenceParams) (GetSumProductsHistoryByReferenceRow, error) {
+	row := q.db.QueryRowContext(ctx, getSumProductsHistoryByReference,
+		arg.ReferenceType,
+		arg.ReferenceGuid,
+		arg.ProductGuid,
+		arg.HistoryType,
+	)
+	var i GetSumProductsHistoryByReferenceRow
+	err := row.Scan(
+		&i.Quantity,
+		&i.TotalCost,
+	)
+	return i, err
+}
+
 const insertKeluarProductsHistory = `-- name: InsertKeluarProductsHistory :one
 INSERT INTO products_history
-        (tgl_keluar, pegawai_keluar, updated_at, updated_by)
-    VALUES
-        ((now() at time zone 'UTC')::TIMESTAMP, $1, (now() at time zone 'UTC')::TIMESTAMP, $2)
-RETURNING products_history.id, products_history.guid, products_history.product_guid, products_history.quantity, products_history.warehouse_guid, products_history.tgl_masuk, products_history.pegawai_masuk, products_history.tgl_keluar, products_history.pegawai_keluar, products_history.created_at, products_history.created_by, products_history.updated_at, products_history.updated_by, products_history.deleted_at, products_history.deleted_by
+(guid, product_guid, quantity, warehouse_guid, history_type, tgl_keluar, pegawai_keluar, created_at, created_by, reference_type, reference_guid, bin_guid, lot_number, expiry_date, unit, unit_quantity, unit_cost, total_cost, customer_guid, customer_address_guid, reason_code, stock_status)
+VALUES
+    ($1, $2, $3, $4, 'keluar', (now() at time zone 'UTC')::TIMESTAMP, $5, (now() at time zone 'UTC')::TIMESTAMP, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+RETURNING products_history.id, products_history.guid, products_history.product_guid, products_history.quantity, products_history.warehouse_guid, products_history.tgl_masuk, products_history.pegawai_masuk, products_history.tgl_keluar, products_history.pegawai_keluar, products_history.created_at, products_history.created_by, products_history.updated_at, products_history.updated_by, products_history.deleted_at, products_history.deleted_by, products_history.history_type, products_history.reference_type, products_history.reference_guid, products_history.bin_guid, products_history.lot_number, products_history.expiry_date, products_history.unit, products_history.unit_quantity, products_history.unit_cost, products_history.total_cost, products_history.customer_guid, products_history.customer_address_guid, products_history.reason_code, products_history.stock_status
 `
 
 type InsertKeluarProductsHistoryParams struct {
-	PegawaiKeluar string         `json:"pegawai_keluar"`
-	UpdatedBy     sql.NullString `json:"updated_by"`
+	Guid                string         `json:"guid"`
+	ProductGuid         string         `json:"product_guid"`
+	Quantity            int64          `json:"quantity"`
+	WarehouseGuid       string         `json:"warehouse_guid"`
+	PegawaiKeluar       string         `json:"pegawai_keluar"`
+	CreatedBy           string         `json:"created_by"`
+	ReferenceType       sql.NullString `json:"reference_type"`
+	ReferenceGuid       sql.NullString `json:"reference_guid"`
+	BinGuid             sql.NullString `json:"bin_guid"`
+	LotNumber           sql.NullString `json:"lot_number"`
+	ExpiryDate          sql.NullTime   `json:"expiry_date"`
+	Unit                sql.NullString `json:"unit"`
+	UnitQuantity        sql.NullInt64  `json:"unit_quantity"`
+	UnitCost            sql.NullInt64  `json:"unit_cost"`
+	TotalCost           sql.NullInt64  `json:"total_cost"`
+	CustomerGuid        sql.NullString `json:"customer_guid"`
+	CustomerAddressGuid sql.NullString `json:"customer_address_guid"`
+	ReasonCode          sql.NullString `json:"reason_code"`
+	StockStatus         string         `json:"stock_status"`
 }
 
 func (q *Queries) InsertKeluarProductsHistory(ctx context.Context, arg InsertKeluarProductsHistoryParams) (ProductsHistory, error) {
-	row := q.db.QueryRowContext(ctx, insertKeluarProductsHistory, arg.PegawaiKeluar, arg.UpdatedBy)
+	row := q.db.QueryRowContext(ctx, insertKeluarProductsHistory,
+		arg.Guid,
+		arg.ProductGuid,
+		arg.Quantity,
+		arg.WarehouseGuid,
+		arg.PegawaiKeluar,
+		arg.CreatedBy,
+		arg.ReferenceType,
+		arg.ReferenceGuid,
+		arg.BinGuid,
+		arg.LotNumber,
+		arg.ExpiryDate,
+		arg.Unit,
+		arg.UnitQuantity,
+		arg.UnitCost,
+		arg.TotalCost,
+		arg.CustomerGuid,
+		arg.CustomerAddressGuid,
+		arg.ReasonCode,
+		arg.StockStatus,
+	)
 	var i ProductsHistory
 	err := row.Scan(
 		&i.ID,
@@ -120,25 +324,52 @@ func (q *Queries) InsertKeluarProductsHistory(ctx context.Context, arg InsertKel
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.HistoryType,
+		&i.ReferenceType,
+		&i.ReferenceGuid,
+		&i.BinGuid,
+		&i.LotNumber,
+		&i.ExpiryDate,
+		&i.Unit,
+		&i.UnitQuantity,
+		&i.UnitCost,
+		&i.TotalCost,
+		&i.CustomerGuid,
+		&i.CustomerAddressGuid,
+		&i.ReasonCode,
+		&i.StockStatus,
 	)
 	return i, err
 }
 
 const insertProductsHistory = `-- name: InsertProductsHistory :one
 INSERT INTO products_history
-(guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, created_at, created_by)
+(guid, product_guid, quantity, warehouse_guid, history_type, tgl_masuk, pegawai_masuk, created_at, created_by, reference_type, reference_guid, bin_guid, lot_number, expiry_date, unit, unit_quantity, unit_cost, total_cost, customer_guid, customer_address_guid, reason_code, stock_status)
 VALUES
-    ($1, $2, $3, $4, (now() at time zone 'UTC')::TIMESTAMP, $5, (now() at time zone 'UTC')::TIMESTAMP, $6)
-RETURNING products_history.id, products_history.guid, products_history.product_guid, products_history.quantity, products_history.warehouse_guid, products_history.tgl_masuk, products_history.pegawai_masuk, products_history.tgl_keluar, products_history.pegawai_keluar, products_history.created_at, products_history.created_by, products_history.updated_at, products_history.updated_by, products_history.deleted_at, products_history.deleted_by
+    ($1, $2, $3, $4, 'masuk', (now() at time zone 'UTC')::TIMESTAMP, $5, (now() at time zone 'UTC')::TIMESTAMP, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+RETURNING products_history.id, products_history.guid, products_history.product_guid, products_history.quantity, products_history.warehouse_guid, products_history.tgl_masuk, products_history.pegawai_masuk, products_history.tgl_keluar, products_history.pegawai_keluar, products_history.created_at, products_history.created_by, products_history.updated_at, products_history.updated_by, products_history.deleted_at, products_history.deleted_by, products_history.history_type, products_history.reference_type, products_history.reference_guid, products_history.bin_guid, products_history.lot_number, products_history.expiry_date, products_history.unit, products_history.unit_quantity, products_history.unit_cost, products_history.total_cost, products_history.customer_guid, products_history.customer_address_guid, products_history.reason_code, products_history.stock_status
 `
 
 type InsertProductsHistoryParams struct {
-	Guid          string `json:"guid"`
-	ProductGuid   string `json:"product_guid"`
-	Quantity      int64  `json:"quantity"`
-	WarehouseGuid string `json:"warehouse_guid"`
-	PegawaiMasuk  string `json:"pegawai_masuk"`
-	CreatedBy     string `json:"created_by"`
+	Guid                string         `json:"guid"`
+	ProductGuid         string         `json:"product_guid"`
+	Quantity            int64          `json:"quantity"`
+	WarehouseGuid       string         `json:"warehouse_guid"`
+	PegawaiMasuk        string         `json:"pegawai_masuk"`
+	CreatedBy           string         `json:"created_by"`
+	ReferenceType       sql.NullString `json:"reference_type"`
+	ReferenceGuid       sql.NullString `json:"reference_guid"`
+	BinGuid             sql.NullString `json:"bin_guid"`
+	LotNumber           sql.NullString `json:"lot_number"`
+	ExpiryDate          sql.NullTime   `json:"expiry_date"`
+	Unit                sql.NullString `json:"unit"`
+	UnitQuantity        sql.NullInt64  `json:"unit_quantity"`
+	UnitCost            sql.NullInt64  `json:"unit_cost"`
+	TotalCost           sql.NullInt64  `json:"total_cost"`
+	CustomerGuid        sql.NullString `json:"customer_guid"`
+	CustomerAddressGuid sql.NullString `json:"customer_address_guid"`
+	ReasonCode          sql.NullString `json:"reason_code"`
+	StockStatus         string         `json:"stock_status"`
 }
 
 func (q *Queries) InsertProductsHistory(ctx context.Context, arg InsertProductsHistoryParams) (ProductsHistory, error) {
@@ -149,6 +380,19 @@ func (q *Queries) InsertProductsHistory(ctx context.Context, arg InsertProductsH
 		arg.WarehouseGuid,
 		arg.PegawaiMasuk,
 		arg.CreatedBy,
+		arg.ReferenceType,
+		arg.ReferenceGuid,
+		arg.BinGuid,
+		arg.LotNumber,
+		arg.ExpiryDate,
+		arg.Unit,
+		arg.UnitQuantity,
+		arg.UnitCost,
+		arg.TotalCost,
+		arg.CustomerGuid,
+		arg.CustomerAddressGuid,
+		arg.ReasonCode,
+		arg.StockStatus,
 	)
 	var i ProductsHistory
 	err := row.Scan(
@@ -167,48 +411,215 @@ func (q *Queries) InsertProductsHistory(ctx context.Context, arg InsertProductsH
 		&i.UpdatedBy,
 		&i.DeletedAt,
 		&i.DeletedBy,
+		&i.HistoryType,
+		&i.ReferenceType,
+		&i.ReferenceGuid,
+		&i.BinGuid,
+		&i.LotNumber,
+		&i.ExpiryDate,
+		&i.Unit,
+		&i.UnitQuantity,
+		&i.UnitCost,
+		&i.TotalCost,
+		&i.CustomerGuid,
+		&i.CustomerAddressGuid,
+		&i.ReasonCode,
+		&i.StockStatus,
 	)
 	return i, err
 }
 
+const listLotProductsHistoryByReference = `-- name: ListLotProductsHistoryByReference :many
+SELECT
+    lot_number::varchar AS lot_number,
+    expiry_date,
+    SUM(quantity)::bigint AS quantity
+FROM products_history
+WHERE
+    reference_type = $1
+  AND reference_guid = $2
+  AND product_guid = $3
+  AND history_type = $4
+  AND lot_number IS NOT NULL
+  AND deleted_at IS NULL
+GROUP BY lot_number, expiry_date
+ORDER BY expiry_date ASC NULLS LAST, lot_number ASC
+`
+
+type ListLotProductsHistoryByReferenceParams struct {
+	ReferenceType sql.NullString `json:"reference_type"`
+	ReferenceGuid sql.NullString `json:"reference_guid"`
+	ProductGuid   string         `json:"product_guid"`
+	HistoryType   string         `json:"history_type"`
+}
+
+type ListLotProductsHistoryByReferenceRow struct {
+	LotNumber  string       `json:"lot_number"`
+	ExpiryDate sql.NullTime `json:"expiry_date"`
+	Quantity   int64        `json:"quantity"`
+}
+
+func (q *Queries) ListLotProductsHistoryByReference(ctx context.Context, arg ListLotProductsHistoryByReferenceParams) ([]ListLotProductsHistoryByReferenceRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLotProductsHistoryByReference,
+		arg.ReferenceType,
+		arg.ReferenceGuid,
+		arg.ProductGuid,
+		arg.HistoryType,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListLotProductsHistoryByReferenceRow
+	for rows.Next() {
+		var i ListLotProductsHistoryByReferenceRow
+		if err := rows.Scan(
+			&i.LotNumber,
+			&i.ExpiryDate,
+			&i.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductsHistoryByReference = `-- name: ListProductsHistoryByReference :many
+SELECT id, guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, tgl_keluar, pegawai_keluar, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, history_type, reference_type, reference_guid, bin_guid, lot_number, expiry_date, unit, unit_quantity, unit_cost, total_cost, customer_guid, customer_address_guid, reason_code, stock_status
+FROM products_history
+WHERE
+    reference_type = $1
+  AND reference_guid = $2
+  AND deleted_at IS NULL
+ORDER BY id ASC
+`
+
+type ListProductsHistoryByReferenceParams struct {
+	ReferenceType sql.NullString `json:"reference_type"`
+	ReferenceGuid sql.NullString `json:"reference_guid"`
+}
+
+func (q *Queries) ListProductsHistoryByReference(ctx context.Context, arg ListProductsHistoryByReferenceParams) ([]ProductsHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listProductsHistoryByReference, arg.ReferenceType, arg.ReferenceGuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProductsHistory
+	for rows.Next() {
+		var i ProductsHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.Guid,
+			&i.ProductGuid,
+			&i.Quantity,
+			&i.WarehouseGuid,
+			&i.TglMasuk,
+			&i.PegawaiMasuk,
+			&i.TglKeluar,
+			&i.PegawaiKeluar,
+			&i.CreatedAt,
+			&i.CreatedBy,
+			&i.UpdatedAt,
+			&i.UpdatedBy,
+			&i.DeletedAt,
+			&i.DeletedBy,
+			&i.HistoryType,
+			&i.ReferenceType,
+			&i.ReferenceGuid,
+			&i.BinGuid,
+			&i.LotNumber,
+			&i.ExpiryDate,
+			&i.Unit,
+			&i.UnitQuantity,
+			&i.UnitCost,
+			&i.TotalCost,
+			&i.CustomerGuid,
+			&i.CustomerAddressGuid,
+			&i.ReasonCode,
+			&i.StockStatus,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWithFilterProductHistory = `-- name: ListWithFilterProductHistory :many
-SELECT id, guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, tgl_keluar, pegawai_keluar, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by
+SELECT id, guid, product_guid, quantity, warehouse_guid, tgl_masuk, pegawai_masuk, tgl_keluar, pegawai_keluar, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, history_type, reference_type, reference_guid, bin_guid, lot_number, expiry_date, unit, unit_quantity, unit_cost, total_cost, customer_guid, customer_address_guid, reason_code, stock_status
 FROM products_history
 WHERE
     (CASE WHEN $1::bool THEN LOWER(pegawai_masuk) LIKE LOWER($2) ELSE TRUE END)
     AND(CASE WHEN $3::bool THEN LOWER(pegawai_keluar) LIKE LOWER($4) ELSE TRUE END)
+    AND (CASE WHEN $5::bool THEN warehouse_guid = $6 ELSE TRUE END)
+    AND (CASE WHEN $7::bool THEN product_guid = $8 ELSE TRUE END)
+    AND (CASE WHEN $9::bool THEN history_type = $10 ELSE TRUE END)
+    AND (CASE WHEN $11::bool THEN created_at BETWEEN $12 AND $13 ELSE TRUE END)
+    AND (CASE WHEN $14::bool THEN reference_type = $15 AND reference_guid = $16 ELSE TRUE END)
+    AND (CASE WHEN $17::bool THEN customer_guid = $18 ELSE TRUE END)
+    AND (CASE WHEN $19::bool THEN stock_status = $20 ELSE TRUE END)
     AND deleted_at IS NULL
-ORDER BY (CASE WHEN $5 = 'id ASC' THEN guid END) ASC,
-         (CASE WHEN $5 = 'id DESC' THEN guid END) DESC,
-         (CASE WHEN $5 = 'product id ASC' THEN product_guid END) ASC,
-         (CASE WHEN $5 = 'product id DESC' THEN product_guid END) DESC,
-         (CASE WHEN $5 = 'quantity ASC' THEN quantity END) ASC,
-         (CASE WHEN $5 = 'quantity DESC' THEN quantity END) DESC,
-         (CASE WHEN $5 = 'warehouse id ASC' THEN warehouse_guid END) ASC,
-         (CASE WHEN $5 = 'warehouse id DESC' THEN warehouse_guid END) DESC,
-         (CASE WHEN $5 = 'tanggal masuk ASC' THEN tgl_masuk END) ASC,
-         (CASE WHEN $5 = 'tanggal masuk DESC' THEN tgl_masuk END) DESC,
-         (CASE WHEN $5 = 'pegawai masuk DESC' THEN pegawai_masuk END) DESC,
-         (CASE WHEN $5 = 'pegawai masuk ASC' THEN pegawai_masuk END) ASC,
-         (CASE WHEN $5 = 'tanggal keluar ASC' THEN tgl_keluar END) ASC,
-         (CASE WHEN $5 = 'tanggal keluar DESC' THEN tgl_keluar END) DESC,
-         (CASE WHEN $5 = 'pegawai keluar DESC' THEN pegawai_keluar END) DESC,
-         (CASE WHEN $5 = 'pegawai keluar ASC' THEN pegawai_keluar END) ASC,
-         (CASE WHEN $5 = 'created_at ASC' THEN created_at END) ASC,
-         (CASE WHEN $5 = 'created_at DESC' THEN created_at END) DESC,
+ORDER BY (CASE WHEN $21 = 'id ASC' THEN guid END) ASC,
+         (CASE WHEN $21 = 'id DESC' THEN guid END) DESC,
+         (CASE WHEN $21 = 'product id ASC' THEN product_guid END) ASC,
+         (CASE WHEN $21 = 'product id DESC' THEN product_guid END) DESC,
+         (CASE WHEN $21 = 'quantity ASC' THEN quantity END) ASC,
+         (CASE WHEN $21 = 'quantity DESC' THEN quantity END) DESC,
+         (CASE WHEN $21 = 'warehouse id ASC' THEN warehouse_guid END) ASC,
+         (CASE WHEN $21 = 'warehouse id DESC' THEN warehouse_guid END) DESC,
+         (CASE WHEN $21 = 'tanggal masuk ASC' THEN tgl_masuk END) ASC,
+         (CASE WHEN $21 = 'tanggal masuk DESC' THEN tgl_masuk END) DESC,
+         (CASE WHEN $21 = 'pegawai masuk DESC' THEN pegawai_masuk END) DESC,
+         (CASE WHEN $21 = 'pegawai masuk ASC' THEN pegawai_masuk END) ASC,
+         (CASE WHEN $21 = 'tanggal keluar ASC' THEN tgl_keluar END) ASC,
+         (CASE WHEN $21 = 'tanggal keluar DESC' THEN tgl_keluar END) DESC,
+         (CASE WHEN $21 = 'pegawai keluar DESC' THEN pegawai_keluar END) DESC,
+         (CASE WHEN $21 = 'pegawai keluar ASC' THEN pegawai_keluar END) ASC,
+         (CASE WHEN $21 = 'created_at ASC' THEN created_at END) ASC,
+         (CASE WHEN $21 = 'created_at DESC' THEN created_at END) DESC,
          products_history.created_at DESC
-LIMIT $7
-OFFSET $6
+LIMIT $23
+OFFSET $22
 `
 
 type ListWithFilterProductHistoryParams struct {
-	SetPegawaiMasuk  bool        `json:"set_pegawai_masuk"`
-	PegawaiMasuk     string      `json:"pegawai_masuk"`
-	SetPegawaiKeluar bool        `json:"set_pegawai_keluar"`
-	PegawaiKeluar    string      `json:"pegawai_keluar"`
-	OrderParam       interface{} `json:"order_param"`
-	OffsetPage       int32       `json:"offset_page"`
-	LimitData        int32       `json:"limit_data"`
+	SetPegawaiMasuk  bool           `json:"set_pegawai_masuk"`
+	PegawaiMasuk     string         `json:"pegawai_masuk"`
+	SetPegawaiKeluar bool           `json:"set_pegawai_keluar"`
+	PegawaiKeluar    string         `json:"pegawai_keluar"`
+	SetWarehouse     bool           `json:"set_warehouse"`
+	WarehouseGuid    string         `json:"warehouse_guid"`
+	SetProduct       bool           `json:"set_product"`
+	ProductGuid      string         `json:"product_guid"`
+	SetHistoryType   bool           `json:"set_history_type"`
+	HistoryType      string         `json:"history_type"`
+	SetDate          bool           `json:"set_date"`
+	StartDate        time.Time      `json:"start_date"`
+	EndDate          time.Time      `json:"end_date"`
+	SetReference     bool           `json:"set_reference"`
+	ReferenceType    sql.NullString `json:"reference_type"`
+	ReferenceGuid    sql.NullString `json:"reference_guid"`
+	SetCustomer      bool           `json:"set_customer"`
+	CustomerGuid     sql.NullString `json:"customer_guid"`
+	SetStockStatus   bool           `json:"set_stock_status"`
+	StockStatus      string         `json:"stock_status"`
+	OrderParam       interface{}    `json:"order_param"`
+	OffsetPage       int32          `json:"offset_page"`
+	LimitData        int32          `json:"limit_data"`
 }
 
 func (q *Queries) ListWithFilterProductHistory(ctx context.Context, arg ListWithFilterProductHistoryParams) ([]ProductsHistory, error) {
@@ -217,6 +628,22 @@ func (q *Queries) ListWithFilterProductHistory(ctx context.Context, arg ListWith
 		arg.PegawaiMasuk,
 		arg.SetPegawaiKeluar,
 		arg.PegawaiKeluar,
+		arg.SetWarehouse,
+		arg.WarehouseGuid,
+		arg.SetProduct,
+		arg.ProductGuid,
+		arg.SetHistoryType,
+		arg.HistoryType,
+		arg.SetDate,
+		arg.StartDate,
+		arg.EndDate,
+		arg.SetReference,
+		arg.ReferenceType,
+		arg.ReferenceGuid,
+		arg.SetCustomer,
+		arg.CustomerGuid,
+		arg.SetStockStatus,
+		arg.StockStatus,
 		arg.OrderParam,
 		arg.OffsetPage,
 		arg.LimitData,
@@ -244,6 +671,20 @@ func (q *Queries) ListWithFilterProductHistory(ctx context.Context, arg ListWith
 			&i.UpdatedBy,
 			&i.DeletedAt,
 			&i.DeletedBy,
+			&i.HistoryType,
+			&i.ReferenceType,
+			&i.ReferenceGuid,
+			&i.BinGuid,
+			&i.LotNumber,
+			&i.ExpiryDate,
+			&i.Unit,
+			&i.UnitQuantity,
+			&i.UnitCost,
+			&i.TotalCost,
+			&i.CustomerGuid,
+			&i.CustomerAddressGuid,
+			&i.ReasonCode,
+			&i.StockStatus,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,189 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/sales_order/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteSalesOrder(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewSalesOrderService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	salesOrder := e.Group("/sales-order")
+	salesOrder.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "sales order ok")
+	})
+	salesOrder.Use(mddw.ValidateToken)
+	salesOrder.Use(mddw.ValidateUserHandheldLogin)
+
+	salesOrder.GET("/pick-list/:warehouse_guid", listPickList(svc))
+	salesOrder.GET("/:guid", getSalesOrder(svc))
+	salesOrder.POST("/pick/:guid", pickSalesOrder(svc))
+
+	salesOrderBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "sales-order")
+	salesOrderBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "sales order ok")
+	})
+	salesOrderBO.Use(mddw.ValidateToken)
+	salesOrderBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	salesOrderBO.POST("/create", createSalesOrder(svc))
+	salesOrderBO.POST("/list", listSalesOrder(svc))
+	salesOrderBO.GET("/:guid", getSalesOrder(svc))
+	salesOrderBO.POST("/cancel/:guid", cancelSalesOrder(svc))
+}
+
+func createSalesOrder(svc *service.SalesOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.InsertSalesOrderPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		requestOrder := request.ToEntity(userBackoffice.Guid)
+
+		data, listItem, err := svc.CreateSalesOrder(ctx.Request().Context(), requestOrder, request.ToEntityItems(requestOrder.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSalesOrder(data, listItem), nil)
+	}
+}
+
+func listSalesOrder(svc *service.SalesOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListSalesOrderPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListSalesOrder(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListSalesOrder(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getSalesOrder(svc *service.SalesOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listItem, err := svc.GetSalesOrder(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSalesOrder(data, listItem), nil)
+	}
+}
+
+func cancelSalesOrder(svc *service.SalesOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, listItem, err := svc.CancelSalesOrder(ctx.Request().Context(), guid, userBackoffice.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSalesOrder(data, listItem), nil)
+	}
+}
+
+func listPickList(svc *service.SalesOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		warehouseGUID := ctx.Param("warehouse_guid")
+		if warehouseGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListPickListPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListPickList(ctx.Request().Context(), request.ToEntity(warehouseGUID))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListPickList(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func pickSalesOrder(svc *service.SalesOrderService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.PickSalesOrderPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		data, listItem, err := svc.PickSalesOrder(ctx.Request().Context(), guid, request.ToEntity(guid), request.ToEntityMovement(), request.ToEntitySerial(), userHandheld.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSalesOrder(data, listItem), nil)
+	}
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockReservationService "github.com/wit-id/blueprint-backend-go/src/stock_reservation/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// CancelSalesOrder stops an order that is not closed yet and releases the stock still reserved
+// for it. Quantities that were already shipped stay booked out.
+func (s *SalesOrderService) CancelSalesOrder(ctx context.Context, guid string, userGUID string) (salesOrder sqlc.SalesOrder, listItem []sqlc.ListSalesOrderItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	// Lock the order so a concurrent pick can not ship against reservations being released
+	order, err := q.GetSalesOrderForUpdate(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrSalesOrderNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get sales order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if order.Status == constants.SalesOrderStatusClosed || order.Status == constants.SalesOrderStatusCancelled {
+		err = errors.WithStack(httpservice.ErrSalesOrderClosed)
+
+		return
+	}
+
+	if err = stockReservationService.ReleaseStockReservation(ctx, q, constants.StockReservationReferenceSalesOrder, guid); err != nil {
+		return
+	}
+
+	if _, err = q.UpdateSalesOrderStatus(ctx, sqlc.UpdateSalesOrderStatusParams{
+		Status:    constants.SalesOrderStatusCancelled,
+		UpdatedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:      guid,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed update sales order status")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	salesOrder, listItem, err = getSalesOrderWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	customerService "github.com/wit-id/blueprint-backend-go/src/customer/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockReservationService "github.com/wit-id/blueprint-backend-go/src/stock_reservation/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CreateSalesOrder stores the order and reserves every line against the available to promise
+// stock of its warehouse, so two orders can not promise the same units.
+func (s *SalesOrderService) CreateSalesOrder(ctx context.Context, request sqlc.InsertSalesOrderParams, requestItems []sqlc.InsertSalesOrderItemParams) (salesOrder sqlc.SalesOrder, listItem []sqlc.ListSalesOrderItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if err = resolveSalesOrderCustomer(ctx, q, &request); err != nil {
+		return
+	}
+
+	if _, err = q.InsertSalesOrder(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert sales order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range requestItems {
+		product, errGet := q.GetProduct(ctx, requestItems[i].ProductGuid)
+		if errGet != nil || product.DeletedAt.Valid {
+			log.FromCtx(ctx).Error(errGet, "failed get product")
+			err = errors.WithStack(httpservice.ErrProductNotFound)
+
+			return
+		}
+
+		warehouse, errGet := q.GetWarehouse(ctx, requestItems[i].WarehouseGuid)
+		if errGet != nil || warehouse.DeletedAt.Valid {
+			log.FromCtx(ctx).Error(errGet, "failed get warehouse")
+			err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+			return
+		}
+
+		if _, err = q.InsertSalesOrderItem(ctx, requestItems[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert sales order item")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	if err = s.reserveSalesOrder(ctx, q, request, requestItems); err != nil {
+		return
+	}
+
+	salesOrder, listItem, err = getSalesOrderWithItems(ctx, q, request.Guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// reserveSalesOrder reserves the order lines sorted by product and warehouse, so concurrent
+// orders lock the stock balance rows in the same order.
+func (s *SalesOrderService) reserveSalesOrder(ctx context.Context, q *sqlc.Queries, request sqlc.InsertSalesOrderParams, requestItems []sqlc.InsertSalesOrderItemParams) (err error) {
+	var expiresAt sql.NullTime
+
+	if ttl := s.cfg.GetDuration(constants.ConfigStockReservationTTL); ttl > 0 {
+		expiresAt = sql.NullTime{Time: time.Now().UTC().Add(ttl), Valid: true}
+	}
+
+	listReservation := make([]sqlc.InsertStockReservationParams, len(requestItems))
+
+	for i := range requestItems {
+		listReservation[i] = sqlc.InsertStockReservationParams{
+			Guid:              utility.GenerateGoogleUUID(),
+			ProductGuid:       requestItems[i].ProductGuid,
+			WarehouseGuid:     requestItems[i].WarehouseGuid,
+			Quantity:          requestItems[i].QuantityOrdered,
+			ReferenceType:     constants.StockReservationReferenceSalesOrder,
+			ReferenceGuid:     request.Guid,
+			ReferenceItemGuid: requestItems[i].Guid,
+			ExpiresAt:         expiresAt,
+			CreatedBy:         request.CreatedBy,
+		}
+	}
+
+	sort.SliceStable(listReservation, func(i, j int) bool {
+		if listReservation[i].ProductGuid != listReservation[j].ProductGuid {
+			return listReservation[i].ProductGuid < listReservation[j].ProductGuid
+		}
+
+		return listReservation[i].WarehouseGuid < listReservation[j].WarehouseGuid
+	})
+
+	for i := range listReservation {
+		if _, err = stockReservationService.ReserveStock(ctx, q, listReservation[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// resolveSalesOrderCustomer validates the customer of an order and fills the customer name and
+// shipping address from the customer master when the order does not spell them out.
+func resolveSalesOrderCustomer(ctx context.Context, q *sqlc.Queries, request *sqlc.InsertSalesOrderParams) (err error) {
+	customer, address, err := customerService.ResolveCustomerAddress(ctx, q, request.CustomerGuid, request.CustomerAddressGuid)
+	if err != nil {
+		return
+	}
+
+	if customer.Guid != "" && request.CustomerName == "" {
+		request.CustomerName = customer.Name
+	}
+
+	if address.Guid == "" {
+		return
+	}
+
+	request.CustomerAddressGuid = sql.NullString{String: address.Guid, Valid: true}
+
+	if !request.ShippingAddress.Valid {
+		lines := []string{address.Address}
+		for _, line := range []sql.NullString{address.City, address.PostalCode} {
+			if line.Valid {
+				lines = append(lines, line.String)
+			}
+		}
+
+		request.ShippingAddress = sql.NullString{String: strings.Join(lines, ", "), Valid: true}
+	}
+
+	return
+}
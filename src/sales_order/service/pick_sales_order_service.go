@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockReservationService "github.com/wit-id/blueprint-backend-go/src/stock_reservation/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// PickSalesOrder confirms picked quantities, books them out of each line's warehouse
+// against the line's reservation and closes the order once every line is fully shipped.
+func (s *SalesOrderService) PickSalesOrder(ctx context.Context, guid string, request []sqlc.ShipSalesOrderItemParams, movements map[string]sqlc.InsertKeluarProductsHistoryParams, serials map[string][]string, userGUID string) (salesOrder sqlc.SalesOrder, listItem []sqlc.ListSalesOrderItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	// Lock the order so concurrent pickers see each other's quantities before the status is evaluated
+	order, err := q.GetSalesOrderForUpdate(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrSalesOrderNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get sales order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if order.Status == constants.SalesOrderStatusClosed || order.Status == constants.SalesOrderStatusCancelled {
+		err = errors.WithStack(httpservice.ErrSalesOrderClosed)
+
+		return
+	}
+
+	for i := range request {
+		item, errShip := q.ShipSalesOrderItem(ctx, request[i])
+		if errShip != nil {
+			if errors.Is(errShip, sql.ErrNoRows) {
+				err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: item %s not found or quantity exceeds the remaining quantity", request[i].Guid)
+
+				return
+			}
+
+			log.FromCtx(ctx).Error(errShip, "failed ship sales order item")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if err = stockReservationService.ConsumeStockReservation(ctx, q, item.Guid, request[i].QuantityShipped); err != nil {
+			return
+		}
+
+		if _, err = productHistoryService.RecordProductHistoryKeluar(ctx, q, sqlc.InsertKeluarProductsHistoryParams{
+			Guid:                utility.GenerateGoogleUUID(),
+			ProductGuid:         item.ProductGuid,
+			Quantity:            request[i].QuantityShipped,
+			WarehouseGuid:       item.WarehouseGuid,
+			PegawaiKeluar:       userGUID,
+			CreatedBy:           userGUID,
+			ReferenceType:       sql.NullString{String: constants.ProductHistoryReferenceSalesOrder, Valid: true},
+			ReferenceGuid:       sql.NullString{String: guid, Valid: true},
+			BinGuid:             movements[request[i].Guid].BinGuid,
+			LotNumber:           movements[request[i].Guid].LotNumber,
+			CustomerGuid:        order.CustomerGuid,
+			CustomerAddressGuid: order.CustomerAddressGuid,
+		}, serials[request[i].Guid]); err != nil {
+			return
+		}
+	}
+
+	listItem, err = q.ListSalesOrderItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list sales order item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.UpdateSalesOrderStatus(ctx, sqlc.UpdateSalesOrderStatusParams{
+		Status:    salesOrderStatus(listItem),
+		UpdatedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:      guid,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed update sales order status")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	salesOrder, listItem, err = getSalesOrderWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func salesOrderStatus(listItem []sqlc.ListSalesOrderItemRow) string {
+	var shipped, complete int
+
+	for i := range listItem {
+		if listItem[i].QuantityShipped > 0 {
+			shipped++
+		}
+
+		if listItem[i].QuantityShipped >= listItem[i].QuantityOrdered {
+			complete++
+		}
+	}
+
+	switch {
+	case complete == len(listItem):
+		return constants.SalesOrderStatusClosed
+	case shipped > 0:
+		return constants.SalesOrderStatusPartiallyShipped
+	default:
+		return constants.SalesOrderStatusOpen
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+)
+
+func TestSalesOrderStatus(t *testing.T) {
+	// item builds a line with its ordered and shipped quantity
+	item := func(ordered int64, shipped int64) sqlc.ListSalesOrderItemRow {
+		return sqlc.ListSalesOrderItemRow{QuantityOrdered: ordered, QuantityShipped: shipped}
+	}
+
+	tests := []struct {
+		name     string
+		listItem []sqlc.ListSalesOrderItemRow
+		want     string
+	}{
+		{
+			name:     "stays open while nothing is shipped",
+			listItem: []sqlc.ListSalesOrderItemRow{item(5, 0), item(3, 0)},
+			want:     constants.SalesOrderStatusOpen,
+		},
+		{
+			name:     "partially ships a single line",
+			listItem: []sqlc.ListSalesOrderItemRow{item(5, 2)},
+			want:     constants.SalesOrderStatusPartiallyShipped,
+		},
+		{
+			name:     "partially ships when one line is complete and another untouched",
+			listItem: []sqlc.ListSalesOrderItemRow{item(5, 5), item(3, 0)},
+			want:     constants.SalesOrderStatusPartiallyShipped,
+		},
+		{
+			name:     "partially ships when every line is started but one is short",
+			listItem: []sqlc.ListSalesOrderItemRow{item(5, 5), item(3, 1)},
+			want:     constants.SalesOrderStatusPartiallyShipped,
+		},
+		{
+			name:     "closes once every line is shipped in full",
+			listItem: []sqlc.ListSalesOrderItemRow{item(5, 5), item(3, 3)},
+			want:     constants.SalesOrderStatusClosed,
+		},
+		{
+			name:     "closes when a line is shipped over the ordered quantity",
+			listItem: []sqlc.ListSalesOrderItemRow{item(5, 6), item(3, 3)},
+			want:     constants.SalesOrderStatusClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := salesOrderStatus(tt.listItem); got != tt.want {
+				t.Errorf("salesOrderStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
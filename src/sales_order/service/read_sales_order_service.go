@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *SalesOrderService) ListSalesOrder(ctx context.Context, request sqlc.ListSalesOrderParams) (listSalesOrder []sqlc.SalesOrder, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountSalesOrder(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listSalesOrder, err = q.ListSalesOrder(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list sales order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *SalesOrderService) GetSalesOrder(ctx context.Context, guid string) (salesOrder sqlc.SalesOrder, listItem []sqlc.ListSalesOrderItemRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	return getSalesOrderWithItems(ctx, q, guid)
+}
+
+// ListPickList returns the order lines that still have to be picked from a warehouse,
+// oldest order first.
+func (s *SalesOrderService) ListPickList(ctx context.Context, request sqlc.ListSalesOrderPickListParams) (listPickList []sqlc.ListSalesOrderPickListRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	totalData, err = q.GetCountSalesOrderPickList(ctx, request.WarehouseGuid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data pick list")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listPickList, err = q.ListSalesOrderPickList(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list pick list")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *SalesOrderService) getCountSalesOrder(ctx context.Context, q *sqlc.Queries, request sqlc.ListSalesOrderParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountSalesOrderParams{
+		SetOrderNumber:  request.SetOrderNumber,
+		OrderNumber:     request.OrderNumber,
+		SetCustomerName: request.SetCustomerName,
+		CustomerName:    request.CustomerName,
+		SetStatus:       request.SetStatus,
+		Status:          request.Status,
+		SetWarehouse:    request.SetWarehouse,
+		WarehouseGuid:   request.WarehouseGuid,
+	}
+
+	totalData, err = q.GetCountSalesOrder(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list sales order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getSalesOrderWithItems(ctx context.Context, q *sqlc.Queries, guid string) (salesOrder sqlc.SalesOrder, listItem []sqlc.ListSalesOrderItemRow, err error) {
+	salesOrder, err = q.GetSalesOrder(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrSalesOrderNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get sales order")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listItem, err = q.ListSalesOrderItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list sales order item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type SalesOrderService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewSalesOrderService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *SalesOrderService {
+	return &SalesOrderService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
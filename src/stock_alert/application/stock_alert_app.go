@@ -0,0 +1,184 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/stock_alert/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockAlert(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockAlertService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	reorderPointBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "reorder-point")
+	reorderPointBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "reorder point ok")
+	})
+	reorderPointBO.Use(mddw.ValidateToken)
+	reorderPointBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	reorderPointBO.PUT("", upsertStockReorderPoint(svc))
+	reorderPointBO.POST("/list", listStockReorderPoint(svc))
+	reorderPointBO.GET("/:guid", getStockReorderPoint(svc))
+	reorderPointBO.DELETE("/:guid", deleteStockReorderPoint(svc))
+
+	stockAlertBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "alerts")
+	stockAlertBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "alerts ok")
+	})
+	stockAlertBO.Use(mddw.ValidateToken)
+	stockAlertBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockAlertBO.POST("/list", listStockAlert(svc))
+	stockAlertBO.GET("/:guid", getStockAlert(svc))
+	stockAlertBO.POST("/acknowledge/:guid", acknowledgeStockAlert(svc))
+}
+
+func upsertStockReorderPoint(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.UpsertStockReorderPointPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.UpsertStockReorderPoint(ctx.Request().Context(), request.ToEntity(userBackoffice.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockReorderPoint(data), nil)
+	}
+}
+
+func listStockReorderPoint(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockReorderPointPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockReorderPoint(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockReorderPoint(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getStockReorderPoint(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetStockReorderPoint(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockReorderPoint(data), nil)
+	}
+}
+
+func deleteStockReorderPoint(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		if err := svc.DeleteStockReorderPoint(ctx.Request().Context(), guid); err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func listStockAlert(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockAlertPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockAlert(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockAlert(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getStockAlert(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetStockAlert(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockAlert(data), nil)
+	}
+}
+
+func acknowledgeStockAlert(svc *service.StockAlertService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.AcknowledgeStockAlert(ctx.Request().Context(), guid, userBackoffice.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockAlert(data), nil)
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/fcm"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// EvaluateReorderPoint resolves the alerts whose stock has recovered and opens a low stock alert
+// for every product-warehouse pair whose on-hand quantity dropped below its reorder point. The
+// handheld users are notified about the alerts opened by this run.
+func (s *StockAlertService) EvaluateReorderPoint(ctx context.Context, sender fcm.Sender) (listAlert []sqlc.StockAlert, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if err = q.ResolveStockAlertRecovered(ctx); err != nil {
+		log.FromCtx(ctx).Error(err, "failed resolve recovered stock alert")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listBreach, err := q.ListStockReorderPointBreach(ctx)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock reorder point breach")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	var listMessage []fcm.Message
+
+	for i := range listBreach {
+		suggested := listBreach[i].MaxQuantity - listBreach[i].OnHandQuantity
+
+		alert, errInsert := q.InsertStockAlert(ctx, sqlc.InsertStockAlertParams{
+			Guid:              utility.GenerateGoogleUUID(),
+			ProductGuid:       listBreach[i].ProductGuid,
+			WarehouseGuid:     listBreach[i].WarehouseGuid,
+			AlertType:         constants.StockAlertTypeLowStock,
+			OnHandQuantity:    listBreach[i].OnHandQuantity,
+			ReorderPoint:      listBreach[i].ReorderPoint,
+			SuggestedQuantity: suggested,
+		})
+		if errInsert != nil {
+			// another evaluator opened the alert in the meantime
+			if errors.Is(errInsert, sql.ErrNoRows) {
+				continue
+			}
+
+			log.FromCtx(ctx).Error(errInsert, "failed insert stock alert")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		listAlert = append(listAlert, alert)
+		listMessage = append(listMessage, fcm.Message{
+			Title: fmt.Sprintf("Low stock: %s", listBreach[i].ProductName.String),
+			Body: fmt.Sprintf("%s has %d left, reorder point is %d. Suggested reorder: %d.",
+				listBreach[i].WarehouseName.String, listBreach[i].OnHandQuantity, listBreach[i].ReorderPoint, suggested),
+			Data: map[string]string{
+				"type":         "stock_alert",
+				"alert_id":     alert.Guid,
+				"product_id":   alert.ProductGuid,
+				"warehouse_id": alert.WarehouseGuid,
+			},
+		})
+	}
+
+	if len(listAlert) == 0 {
+		return
+	}
+
+	s.notifyStockAlert(ctx, q, sender, listAlert, listMessage)
+
+	return
+}
+
+// notifyStockAlert pushes the messages to every active handheld user. Delivery is best effort:
+// failures are logged and the alert is only marked as notified when a message went out.
+func (s *StockAlertService) notifyStockAlert(ctx context.Context, q *sqlc.Queries, sender fcm.Sender, listAlert []sqlc.StockAlert, listMessage []fcm.Message) {
+	listToken, err := q.ListActiveUserHandheldFcmToken(ctx)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list user handheld fcm token")
+
+		return
+	}
+
+	for i := range listAlert {
+		var delivered bool
+
+		for j := range listToken {
+			if errSend := sender.Send(ctx, listToken[j], listMessage[i]); errSend != nil {
+				log.FromCtx(ctx).Error(errSend, "failed send stock alert notification", "alert_guid", listAlert[i].Guid)
+
+				continue
+			}
+
+			delivered = true
+		}
+
+		if !delivered {
+			continue
+		}
+
+		if errUpdate := q.UpdateStockAlertNotified(ctx, listAlert[i].Guid); errUpdate != nil {
+			log.FromCtx(ctx).Error(errUpdate, "failed update stock alert notified", "alert_guid", listAlert[i].Guid)
+		}
+	}
+}
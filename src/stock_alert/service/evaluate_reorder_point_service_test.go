@@ -0,0 +1,96 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/spf13/viper"
+
+	"github.com/wit-id/blueprint-backend-go/src/stock_alert/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/fcm"
+)
+
+func TestStockAlertService_EvaluateReorderPoint(t *testing.T) {
+	breachColumns := []string{"guid", "product_guid", "warehouse_guid", "min_quantity", "max_quantity", "reorder_point",
+		"created_at", "created_by", "updated_at", "updated_by", "product_name", "warehouse_code", "warehouse_name", "on_hand_quantity"}
+	alertColumns := []string{"id", "guid", "product_guid", "warehouse_guid", "alert_type", "on_hand_quantity", "reorder_point",
+		"suggested_quantity", "status", "notified_at", "acknowledged_at", "acknowledged_by", "resolved_at", "created_at"}
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		alreadyOpened bool
+		listToken     []string
+		wantAlert     int
+		wantSent      int
+	}{
+		{
+			name:      "opens alert and notifies every handheld",
+			listToken: []string{"token-1", "token-2"},
+			wantAlert: 1,
+			wantSent:  2,
+		},
+		{
+			name:          "skips alert opened by another evaluator",
+			alreadyOpened: true,
+			listToken:     []string{"token-1"},
+			wantAlert:     0,
+			wantSent:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New() error = %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec("-- name: ResolveStockAlertRecovered").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery("-- name: ListStockReorderPointBreach").WillReturnRows(sqlmock.NewRows(breachColumns).
+				AddRow("rp-1", "product-1", "warehouse-1", 5, 50, 10, now, "user-1", nil, nil, "Product 1", "WH-1", "Warehouse 1", 3))
+
+			insert := mock.ExpectQuery("-- name: InsertStockAlert")
+			if tt.alreadyOpened {
+				insert.WillReturnRows(sqlmock.NewRows(alertColumns))
+			} else {
+				insert.WillReturnRows(sqlmock.NewRows(alertColumns).
+					AddRow(1, "alert-1", "product-1", "warehouse-1", "low_stock", 3, 10, 47, "open", nil, nil, nil, nil, now))
+
+				tokenRows := sqlmock.NewRows([]string{"fcm_token"})
+				for i := range tt.listToken {
+					tokenRows.AddRow(tt.listToken[i])
+				}
+
+				mock.ExpectQuery("-- name: ListActiveUserHandheldFcmToken").WillReturnRows(tokenRows)
+				mock.ExpectExec("-- name: UpdateStockAlertNotified").WithArgs("alert-1").WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			sender := &fcm.FakeSender{}
+			s := service.NewStockAlertService(db, viper.New())
+
+			gotAlert, err := s.EvaluateReorderPoint(ctx, sender)
+			if err != nil {
+				t.Errorf("EvaluateReorderPoint() error = %v", err)
+				return
+			}
+
+			if len(gotAlert) != tt.wantAlert {
+				t.Errorf("EvaluateReorderPoint() got %d alerts, want %d", len(gotAlert), tt.wantAlert)
+			}
+
+			if gotSent := sender.Sent(); len(gotSent) != tt.wantSent {
+				t.Errorf("EvaluateReorderPoint() sent %d messages, want %d", len(gotSent), tt.wantSent)
+			}
+
+			if err = mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
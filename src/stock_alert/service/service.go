@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockAlertService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockAlertService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockAlertService {
+	return &StockAlertService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
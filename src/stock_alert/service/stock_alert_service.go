@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *StockAlertService) ListStockAlert(ctx context.Context, request sqlc.ListStockAlertParams) (listAlert []sqlc.ListStockAlertRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	totalData, err = q.GetCountStockAlert(ctx, sqlc.GetCountStockAlertParams{
+		SetStatus:     request.SetStatus,
+		Status:        request.Status,
+		SetWarehouse:  request.SetWarehouse,
+		WarehouseGuid: request.WarehouseGuid,
+		SetProduct:    request.SetProduct,
+		ProductGuid:   request.ProductGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock alert")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listAlert, err = q.ListStockAlert(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock alert")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockAlertService) GetStockAlert(ctx context.Context, guid string) (alert sqlc.GetStockAlertRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	alert, err = q.GetStockAlert(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockAlertNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock alert")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// AcknowledgeStockAlert marks an open alert as seen. It stays unresolved until the stock recovers.
+func (s *StockAlertService) AcknowledgeStockAlert(ctx context.Context, guid string, userGUID string) (alert sqlc.GetStockAlertRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.AcknowledgeStockAlert(ctx, sqlc.AcknowledgeStockAlertParams{
+		AcknowledgedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:           guid,
+	}); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(err, "failed acknowledge stock alert")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if _, err = s.GetStockAlert(ctx, guid); err != nil {
+			return
+		}
+
+		err = errors.WithStack(httpservice.ErrStockAlertClosed)
+
+		return
+	}
+
+	return s.GetStockAlert(ctx, guid)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// UpsertStockReorderPoint creates or replaces the min/max/reorder point setting of a product in a warehouse.
+func (s *StockAlertService) UpsertStockReorderPoint(ctx context.Context, request sqlc.UpsertStockReorderPointParams) (reorderPoint sqlc.GetStockReorderPointRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	product, err := q.GetProduct(ctx, request.ProductGuid)
+	if err != nil || product.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product")
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	warehouse, err := q.GetWarehouse(ctx, request.WarehouseGuid)
+	if err != nil || warehouse.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	data, err := q.UpsertStockReorderPoint(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed upsert stock reorder point")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return s.GetStockReorderPoint(ctx, data.Guid)
+}
+
+func (s *StockAlertService) GetStockReorderPoint(ctx context.Context, guid string) (reorderPoint sqlc.GetStockReorderPointRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	reorderPoint, err = q.GetStockReorderPoint(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockReorderPointNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock reorder point")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockAlertService) ListStockReorderPoint(ctx context.Context, request sqlc.ListStockReorderPointParams) (listReorderPoint []sqlc.ListStockReorderPointRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	totalData, err = q.GetCountStockReorderPoint(ctx, sqlc.GetCountStockReorderPointParams{
+		SetWarehouse:      request.SetWarehouse,
+		WarehouseGuid:     request.WarehouseGuid,
+		SetProduct:        request.SetProduct,
+		ProductGuid:       request.ProductGuid,
+		BelowReorderPoint: request.BelowReorderPoint,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock reorder point")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listReorderPoint, err = q.ListStockReorderPoint(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock reorder point")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// DeleteStockReorderPoint removes the setting. Its open alert is resolved by the next evaluation.
+func (s *StockAlertService) DeleteStockReorderPoint(ctx context.Context, guid string) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = s.GetStockReorderPoint(ctx, guid); err != nil {
+		return
+	}
+
+	if err = q.DeleteStockReorderPoint(ctx, guid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete stock reorder point")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
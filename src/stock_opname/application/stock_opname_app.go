@@ -0,0 +1,193 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/stock_opname/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockOpname(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockOpnameService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	stockOpname := e.Group("/stock-opname")
+	stockOpname.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock opname ok")
+	})
+	stockOpname.Use(mddw.ValidateToken)
+	stockOpname.Use(mddw.ValidateUserHandheldLogin)
+
+	stockOpname.POST("/list", listStockOpname(svc))
+	stockOpname.GET("/:guid", getStockOpname(svc))
+	stockOpname.POST("/count/:guid", countStockOpname(svc))
+
+	stockOpnameBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "stock-opname")
+	stockOpnameBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock opname ok")
+	})
+	stockOpnameBO.Use(mddw.ValidateToken)
+	stockOpnameBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockOpnameBO.POST("/create", createStockOpname(svc))
+	stockOpnameBO.POST("/list", listStockOpname(svc))
+	stockOpnameBO.GET("/:guid", getStockOpname(svc))
+	stockOpnameBO.GET("/:guid/report", getStockOpnameReport(svc))
+	stockOpnameBO.POST("/approve/:guid", mddw.ValidateRole(approveStockOpname(svc), constants.AccessApproval))
+	stockOpnameBO.POST("/cancel/:guid", cancelStockOpname(svc))
+}
+
+func createStockOpname(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.InsertStockOpnamePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.CreateStockOpname(ctx.Request().Context(), request.ToEntity(userBackoffice.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockOpname(data, nil), nil)
+	}
+}
+
+func countStockOpname(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.CountStockOpnamePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		data, listItem, err := svc.CountStockOpname(ctx.Request().Context(), guid, request.ToEntity(guid, userHandheld.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockOpname(data, listItem), nil)
+	}
+}
+
+func listStockOpname(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockOpnamePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockOpname(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockOpname(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getStockOpname(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listItem, err := svc.GetStockOpname(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockOpname(data, listItem), nil)
+	}
+}
+
+func getStockOpnameReport(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listItem, err := svc.GetStockOpname(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockOpnameReport(data, listItem), nil)
+	}
+}
+
+func approveStockOpname(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, listItem, err := svc.ApproveStockOpname(ctx.Request().Context(), guid, userBackoffice.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockOpnameReport(data, listItem), nil)
+	}
+}
+
+func cancelStockOpname(svc *service.StockOpnameService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.CancelStockOpname(ctx.Request().Context(), guid, userBackoffice.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockOpname(data, nil), nil)
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *StockOpnameService) CreateStockOpname(ctx context.Context, request sqlc.InsertStockOpnameParams) (stockOpname sqlc.GetStockOpnameRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	warehouse, err := q.GetWarehouse(ctx, request.WarehouseGuid)
+	if err != nil || warehouse.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	if _, err = q.InsertStockOpname(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	stockOpname, err = q.GetStockOpname(ctx, request.Guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// CountStockOpname stores counted quantities from a handheld together with the stock at
+// count time. Counting the same product again overwrites the previous count.
+func (s *StockOpnameService) CountStockOpname(ctx context.Context, guid string, request []sqlc.UpsertStockOpnameItemParams) (stockOpname sqlc.GetStockOpnameRow, listItem []sqlc.ListStockOpnameItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	stockOpname, err = getOpenStockOpname(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	for i := range request {
+		product, errGet := q.GetProduct(ctx, request[i].ProductGuid)
+		if errGet != nil || product.DeletedAt.Valid {
+			log.FromCtx(ctx).Error(errGet, "failed get product")
+			err = errors.WithStack(httpservice.ErrProductNotFound)
+
+			return
+		}
+
+		// Serialized stock is corrected by moving the individual serials, not by a counted variance
+		if product.IsSerialized {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: serialized product %s cannot be counted in a stock opname", request[i].ProductGuid)
+
+			return
+		}
+
+		// The stock at count time is what the counted quantity is compared to on approval
+		request[i].ExpectedQuantity.Int64, err = getStockQuantity(ctx, q, request[i].ProductGuid, stockOpname.WarehouseGuid)
+		if err != nil {
+			return
+		}
+
+		request[i].ExpectedQuantity.Valid = true
+
+		if _, err = q.UpsertStockOpnameItem(ctx, request[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed upsert stock opname item")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	stockOpname, listItem, err = getStockOpnameWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getOpenStockOpname(ctx context.Context, q *sqlc.Queries, guid string) (stockOpname sqlc.GetStockOpnameRow, err error) {
+	stockOpname, err = q.GetStockOpname(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockOpnameNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if stockOpname.Status != constants.StockOpnameStatusOpen {
+		err = errors.WithStack(httpservice.ErrInvalidStockOpname)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *StockOpnameService) ListStockOpname(ctx context.Context, request sqlc.ListStockOpnameParams) (listStockOpname []sqlc.ListStockOpnameRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountStockOpname(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listStockOpname, err = q.ListStockOpname(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockOpnameService) GetStockOpname(ctx context.Context, guid string) (stockOpname sqlc.GetStockOpnameRow, listItem []sqlc.ListStockOpnameItemRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	return getStockOpnameWithItems(ctx, q, guid)
+}
+
+func (s *StockOpnameService) getCountStockOpname(ctx context.Context, q *sqlc.Queries, request sqlc.ListStockOpnameParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountStockOpnameParams{
+		SetOpnameCode: request.SetOpnameCode,
+		OpnameCode:    request.OpnameCode,
+		SetStatus:     request.SetStatus,
+		Status:        request.Status,
+		SetWarehouse:  request.SetWarehouse,
+		WarehouseGuid: request.WarehouseGuid,
+	}
+
+	totalData, err = q.GetCountStockOpname(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getStockOpnameWithItems(ctx context.Context, q *sqlc.Queries, guid string) (stockOpname sqlc.GetStockOpnameRow, listItem []sqlc.ListStockOpnameItemRow, err error) {
+	stockOpname, err = q.GetStockOpname(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockOpnameNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listItem, err = q.ListStockOpnameItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock opname item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
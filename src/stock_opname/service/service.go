@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockOpnameService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockOpnameService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockOpnameService {
+	return &StockOpnameService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ApproveStockOpname closes an open count session. The difference between the counted
+// quantity and the stock at count time is posted as an inbound or outbound adjustment, so
+// movements booked while the session was open are kept.
+func (s *StockOpnameService) ApproveStockOpname(ctx context.Context, guid string, userGUID string) (stockOpname sqlc.GetStockOpnameRow, listItem []sqlc.ListStockOpnameItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	opname, err := q.ApproveStockOpname(ctx, sqlc.ApproveStockOpnameParams{
+		ApprovedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:       guid,
+	})
+	if err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	listItem, err = q.ListStockOpnameItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock opname item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listItem {
+		// Every count snapshots the stock it is compared to
+		if !listItem[i].ExpectedQuantity.Valid {
+			err = errors.Wrapf(httpservice.ErrUnknownSource, "stock opname item %s has no expected quantity", listItem[i].Guid)
+			log.FromCtx(ctx).Error(err, "failed get stock opname item expected quantity")
+
+			return
+		}
+
+		variance := listItem[i].CountedQuantity - listItem[i].ExpectedQuantity.Int64
+
+		switch {
+		case variance > 0:
+			_, err = productHistoryService.RecordProductHistoryMasuk(ctx, q, sqlc.InsertProductsHistoryParams{
+				Guid:          utility.GenerateGoogleUUID(),
+				ProductGuid:   listItem[i].ProductGuid,
+				Quantity:      variance,
+				WarehouseGuid: opname.WarehouseGuid,
+				PegawaiMasuk:  userGUID,
+				CreatedBy:     userGUID,
+				ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockOpname, Valid: true},
+				ReferenceGuid: sql.NullString{String: guid, Valid: true},
+			}, nil)
+		case variance < 0:
+			err = recordStockOpnameShortage(ctx, q, sqlc.InsertKeluarProductsHistoryParams{
+				ProductGuid:   listItem[i].ProductGuid,
+				Quantity:      -variance,
+				WarehouseGuid: opname.WarehouseGuid,
+				PegawaiKeluar: userGUID,
+				CreatedBy:     userGUID,
+				ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockOpname, Valid: true},
+				ReferenceGuid: sql.NullString{String: guid, Valid: true},
+			})
+		}
+
+		if err != nil {
+			return
+		}
+	}
+
+	stockOpname, listItem, err = getStockOpnameWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockOpnameService) CancelStockOpname(ctx context.Context, guid string, userGUID string) (stockOpname sqlc.GetStockOpnameRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	if _, err = q.CancelStockOpname(ctx, sqlc.CancelStockOpnameParams{
+		CancelledBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:        guid,
+	}); err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	stockOpname, err = q.GetStockOpname(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get stock opname")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// transitionError tells a missing session apart from one that is no longer open, since
+// both surface as sql.ErrNoRows from the guarded update.
+func transitionError(ctx context.Context, q *sqlc.Queries, guid string, errUpdate error) (err error) {
+	if !errors.Is(errUpdate, sql.ErrNoRows) {
+		log.FromCtx(ctx).Error(errUpdate, "failed update stock opname status")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.GetStockOpname(ctx, guid); err != nil {
+		err = errors.WithStack(httpservice.ErrStockOpnameNotFound)
+
+		return
+	}
+
+	err = errors.WithStack(httpservice.ErrInvalidStockOpname)
+
+	return
+}
+
+// recordStockOpnameShortage books missing stock out of available stock first and then out of
+// the held statuses, since a count covers every unit on hand whatever its status.
+func recordStockOpnameShortage(ctx context.Context, q *sqlc.Queries, request sqlc.InsertKeluarProductsHistoryParams) (err error) {
+	onHand, err := getStockQuantity(ctx, q, request.ProductGuid, request.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	listHeld, err := q.ListStockStatusBalance(ctx, sqlc.ListStockStatusBalanceParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock status balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	available := onHand
+	for i := range listHeld {
+		available -= listHeld[i].Quantity
+	}
+
+	listStatus := append([]sqlc.StockStatusBalance{{StockStatus: constants.StockStatusAvailable, Quantity: available}}, listHeld...)
+	shortage := request.Quantity
+
+	for i := range listStatus {
+		take := listStatus[i].Quantity
+		if take > shortage {
+			take = shortage
+		}
+
+		if take <= 0 {
+			continue
+		}
+
+		statusRequest := request
+		statusRequest.Guid = utility.GenerateGoogleUUID()
+		statusRequest.Quantity = take
+		statusRequest.StockStatus = listStatus[i].StockStatus
+
+		if _, err = productHistoryService.RecordProductHistoryKeluar(ctx, q, statusRequest, nil); err != nil {
+			return
+		}
+
+		shortage -= take
+	}
+
+	if shortage > 0 {
+		err = errors.Wrapf(httpservice.ErrInsufficientStock, "%d of the counted shortage already left stock while the count was open", shortage)
+		return
+	}
+
+	return
+}
+
+// getStockQuantity locks and returns the on-hand stock of a product, a product never stocked
+// in the warehouse reads as zero.
+func getStockQuantity(ctx context.Context, q *sqlc.Queries, productGUID string, warehouseGUID string) (quantity int64, err error) {
+	balance, err := q.GetStockBalanceForUpdate(ctx, sqlc.GetStockBalanceForUpdateParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	quantity = balance.Quantity
+
+	return
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	"github.com/wit-id/blueprint-backend-go/src/stock_reservation/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockReservation(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockReservationService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	stockReservationBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "stock-reservation")
+	stockReservationBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock reservation ok")
+	})
+	stockReservationBO.Use(mddw.ValidateToken)
+	stockReservationBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockReservationBO.POST("/list", listStockReservation(svc))
+}
+
+func listStockReservation(svc *service.StockReservationService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockReservationPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockReservation(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockReservation(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
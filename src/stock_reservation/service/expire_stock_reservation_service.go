@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ExpireStockReservation marks timed out reservations as expired. Available to promise already
+// ignores them once expires_at has passed, so this only keeps the stored status accurate.
+func (s *StockReservationService) ExpireStockReservation(ctx context.Context) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	if err = q.ExpireStockReservation(ctx); err != nil {
+		log.FromCtx(ctx).Error(err, "failed expire stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
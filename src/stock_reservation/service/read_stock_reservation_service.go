@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *StockReservationService) ListStockReservation(ctx context.Context, request sqlc.ListStockReservationParams) (listReservation []sqlc.ListStockReservationRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	totalData, err = q.GetCountStockReservation(ctx, sqlc.GetCountStockReservationParams{
+		SetWarehouse:  request.SetWarehouse,
+		WarehouseGuid: request.WarehouseGuid,
+		SetProduct:    request.SetProduct,
+		ProductGuid:   request.ProductGuid,
+		SetStatus:     request.SetStatus,
+		Status:        request.Status,
+		SetReference:  request.SetReference,
+		ReferenceGuid: request.ReferenceGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listReservation, err = q.ListStockReservation(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
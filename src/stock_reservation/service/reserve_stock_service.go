@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	stockStatusService "github.com/wit-id/blueprint-backend-go/src/stock_status/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ReserveStock reserves the quantity for a document line when the warehouse still has enough
+// available to promise (on-hand minus stock held in quarantine, damaged or on hold, minus
+// active reservations). The stock balance row is locked first so concurrent documents for the
+// same product and warehouse are checked one after the other; callers reserving several lines
+// should do so in a stable order to avoid deadlocks.
+func ReserveStock(ctx context.Context, q *sqlc.Queries, request sqlc.InsertStockReservationParams) (reservation sqlc.StockReservation, err error) {
+	var onHand int64
+
+	balance, err := q.GetStockBalanceForUpdate(ctx, sqlc.GetStockBalanceForUpdateParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.FromCtx(ctx).Error(err, "failed get stock balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err == nil {
+		onHand = balance.Quantity
+	}
+
+	held, err := stockStatusService.GetHeldStock(ctx, q, request.ProductGuid, request.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	reserved, err := q.GetSumActiveStockReservation(ctx, sqlc.GetSumActiveStockReservationParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get sum active stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if available := onHand - held - reserved; available < request.Quantity {
+		err = errors.Wrapf(httpservice.ErrInsufficientStock, "product %s has %d available in warehouse %s", request.ProductGuid, available, request.WarehouseGuid)
+
+		return
+	}
+
+	reservation, err = q.InsertStockReservation(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ConsumeStockReservation draws the shipped quantity from the reservation of a document line.
+// Lines without an active reservation, e.g. because it expired, ship from unreserved stock.
+func ConsumeStockReservation(ctx context.Context, q *sqlc.Queries, referenceItemGUID string, quantity int64) (err error) {
+	if err = q.ConsumeStockReservation(ctx, sqlc.ConsumeStockReservationParams{
+		Quantity:          quantity,
+		ReferenceItemGuid: referenceItemGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed consume stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ReleaseStockReservation gives back every active reservation held by a document.
+func ReleaseStockReservation(ctx context.Context, q *sqlc.Queries, referenceType string, referenceGUID string) (err error) {
+	if err = q.ReleaseStockReservationByReference(ctx, sqlc.ReleaseStockReservationByReferenceParams{
+		ReferenceType: referenceType,
+		ReferenceGuid: referenceGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed release stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockReservationService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockReservationService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockReservationService {
+	return &StockReservationService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,191 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/stock_return/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockReturn(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockReturnService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	stockReturn := e.Group("/stock-return")
+	stockReturn.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock return ok")
+	})
+	stockReturn.Use(mddw.ValidateToken)
+	stockReturn.Use(mddw.ValidateUserHandheldLogin)
+
+	stockReturn.POST("/list", listStockReturn(svc))
+	stockReturn.GET("/:guid", getStockReturn(svc))
+	stockReturn.POST("/receive/:guid", receiveStockReturn(svc))
+	stockReturn.POST("/inspect/:guid", inspectStockReturnHandheld(svc))
+
+	stockReturnBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "stock-return")
+	stockReturnBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock return ok")
+	})
+	stockReturnBO.Use(mddw.ValidateToken)
+	stockReturnBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockReturnBO.POST("/create", createStockReturn(svc))
+	stockReturnBO.POST("/list", listStockReturn(svc))
+	stockReturnBO.GET("/:guid", getStockReturn(svc))
+	stockReturnBO.POST("/inspect/:guid", inspectStockReturnBackoffice(svc))
+	stockReturnBO.POST("/cancel/:guid", cancelStockReturn(svc))
+}
+
+func createStockReturn(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.InsertStockReturnPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, err := svc.CreateStockReturn(ctx.Request().Context(), request.ToEntity(userBackoffice.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockReturn(data, nil), nil)
+	}
+}
+
+func listStockReturn(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockReturnPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockReturn(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockReturn(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getStockReturn(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listOutcome, err := svc.GetStockReturn(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockReturn(data, listOutcome), nil)
+	}
+}
+
+func receiveStockReturn(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		data, listOutcome, err := svc.ReceiveStockReturn(ctx.Request().Context(), guid, userHandheld.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockReturn(data, listOutcome), nil)
+	}
+}
+
+func inspectStockReturnHandheld(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		return inspectStockReturn(ctx, svc, userHandheld.Guid)
+	}
+}
+
+func inspectStockReturnBackoffice(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		return inspectStockReturn(ctx, svc, userBackoffice.Guid)
+	}
+}
+
+func inspectStockReturn(ctx echo.Context, svc *service.StockReturnService, userGUID string) error {
+	guid := ctx.Param("guid")
+	if guid == "" {
+		return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+	}
+
+	var request payload.InspectStockReturnPayload
+	if err := ctx.Bind(&request); err != nil {
+		log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+		return errors.WithStack(httpservice.ErrBadRequest)
+	}
+
+	// Validate request
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	listOutcome, serials := request.ToEntity(guid, userGUID)
+
+	data, listOutcomeData, err := svc.InspectStockReturn(ctx.Request().Context(), guid, listOutcome, serials, userGUID)
+	if err != nil {
+		return err
+	}
+
+	return httpservice.ResponseData(ctx, payload.ToPayloadStockReturn(data, listOutcomeData), nil)
+}
+
+func cancelStockReturn(svc *service.StockReturnService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, listOutcome, err := svc.CancelStockReturn(ctx.Request().Context(), guid, userBackoffice.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockReturn(data, listOutcome), nil)
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	customerService "github.com/wit-id/blueprint-backend-go/src/customer/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// CreateStockReturn opens a return against an outbound movement. The product, warehouse and,
+// unless another one is named, the customer come from the movement, and a movement can not
+// be returned for more than it shipped across all of its returns.
+func (s *StockReturnService) CreateStockReturn(ctx context.Context, request sqlc.InsertStockReturnParams) (stockReturn sqlc.GetStockReturnRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	// Lock the movement so concurrent returns against it see each other's quantities
+	productHistory, err := q.GetProductsHistoryForUpdate(ctx, request.ProductsHistoryGuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrProductHistoryNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get product history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if productHistory.HistoryType != constants.ProductHistoryTypeKeluar {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: goods can only be returned against an outbound movement")
+		return
+	}
+
+	returned, err := q.GetSumStockReturnQuantityByProductsHistory(ctx, productHistory.Guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get returned quantity")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if returned+request.Quantity > productHistory.Quantity {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: only %d of the movement is left to return", productHistory.Quantity-returned)
+		return
+	}
+
+	request.ProductGuid = productHistory.ProductGuid
+	request.WarehouseGuid = productHistory.WarehouseGuid
+
+	if !request.CustomerGuid.Valid {
+		request.CustomerGuid = productHistory.CustomerGuid
+	} else if _, _, err = customerService.ResolveCustomerAddress(ctx, q, request.CustomerGuid, sql.NullString{}); err != nil {
+		return
+	}
+
+	if _, err = q.InsertStockReturn(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert stock return")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	stockReturn, _, err = getStockReturnWithOutcomes(ctx, q, request.Guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
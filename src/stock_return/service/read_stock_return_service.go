@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *StockReturnService) ListStockReturn(ctx context.Context, request sqlc.ListStockReturnParams) (listStockReturn []sqlc.ListStockReturnRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountStockReturn(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listStockReturn, err = q.ListStockReturn(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock return")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockReturnService) GetStockReturn(ctx context.Context, guid string) (stockReturn sqlc.GetStockReturnRow, listOutcome []sqlc.StockReturnOutcome, err error) {
+	q := sqlc.New(s.mainDB)
+
+	return getStockReturnWithOutcomes(ctx, q, guid)
+}
+
+func (s *StockReturnService) getCountStockReturn(ctx context.Context, q *sqlc.Queries, request sqlc.ListStockReturnParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountStockReturnParams{
+		SetReturnNumber: request.SetReturnNumber,
+		ReturnNumber:    request.ReturnNumber,
+		SetStatus:       request.SetStatus,
+		Status:          request.Status,
+		SetCustomer:     request.SetCustomer,
+		CustomerGuid:    request.CustomerGuid,
+		SetWarehouse:    request.SetWarehouse,
+		WarehouseGuid:   request.WarehouseGuid,
+	}
+
+	totalData, err = q.GetCountStockReturn(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock return")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getStockReturnWithOutcomes(ctx context.Context, q *sqlc.Queries, guid string) (stockReturn sqlc.GetStockReturnRow, listOutcome []sqlc.StockReturnOutcome, err error) {
+	stockReturn, err = q.GetStockReturn(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockReturnNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock return")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listOutcome, err = q.ListStockReturnOutcome(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock return outcome")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockReturnService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockReturnService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockReturnService {
+	return &StockReturnService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ReceiveStockReturn records that the returned goods arrived at the warehouse. They stay
+// outside of the stock balance until inspection decides what happens to them.
+func (s *StockReturnService) ReceiveStockReturn(ctx context.Context, guid string, userGUID string) (stockReturn sqlc.GetStockReturnRow, listOutcome []sqlc.StockReturnOutcome, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.ReceiveStockReturn(ctx, sqlc.ReceiveStockReturnParams{
+		ReceivedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:       guid,
+	}); err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	return getStockReturnWithOutcomes(ctx, q, guid)
+}
+
+func (s *StockReturnService) CancelStockReturn(ctx context.Context, guid string, userGUID string) (stockReturn sqlc.GetStockReturnRow, listOutcome []sqlc.StockReturnOutcome, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.CancelStockReturn(ctx, sqlc.CancelStockReturnParams{
+		CancelledBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:        guid,
+	}); err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	return getStockReturnWithOutcomes(ctx, q, guid)
+}
+
+// InspectStockReturn closes a received return with the dispositions of its goods, which must
+// account for the whole returned quantity. Restocked goods come back in as available at the
+// cost they shipped at, quarantined goods come in the same way but in quarantine. Scrapped
+// goods come in as damaged and are written off right away under the given reason code, so
+// the loss shows on an outbound movement.
+func (s *StockReturnService) InspectStockReturn(ctx context.Context, guid string, request []sqlc.InsertStockReturnOutcomeParams, serials [][]string, userGUID string) (stockReturn sqlc.GetStockReturnRow, listOutcome []sqlc.StockReturnOutcome, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	current, err := q.InspectStockReturn(ctx, sqlc.InspectStockReturnParams{
+		InspectedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:        guid,
+	})
+	if err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	var total int64
+	for i := range request {
+		total += request[i].Quantity
+	}
+
+	if total != current.Quantity {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: outcomes must account for the returned quantity of %d", current.Quantity)
+		return
+	}
+
+	origin, err := q.GetProductsHistoryForUpdate(ctx, current.ProductsHistoryGuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrProductHistoryNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get product history")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range request {
+		var movement sqlc.ProductsHistory
+
+		switch request[i].Disposition {
+		case constants.StockReturnDispositionRestock:
+			movement, err = restockStockReturn(ctx, q, current, origin, request[i], serials[i], constants.StockStatusAvailable, userGUID)
+		case constants.StockReturnDispositionQuarantine:
+			movement, err = restockStockReturn(ctx, q, current, origin, request[i], serials[i], constants.StockStatusQuarantine, userGUID)
+		case constants.StockReturnDispositionScrap:
+			movement, err = scrapStockReturn(ctx, q, current, origin, request[i], serials[i], userGUID)
+		}
+
+		if err != nil {
+			return
+		}
+
+		request[i].ProductsHistoryGuid = sql.NullString{String: movement.Guid, Valid: true}
+
+		if _, err = q.InsertStockReturnOutcome(ctx, request[i]); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert stock return outcome")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+	}
+
+	stockReturn, listOutcome, err = getStockReturnWithOutcomes(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// restockStockReturn takes returned goods back into stock in the given status, into the lot
+// they shipped from and at the cost of goods sold of the original movement.
+func restockStockReturn(ctx context.Context, q *sqlc.Queries, stockReturn sqlc.StockReturn, origin sqlc.ProductsHistory, outcome sqlc.InsertStockReturnOutcomeParams, serials []string, stockStatus string, userGUID string) (productHistory sqlc.ProductsHistory, err error) {
+	var totalCost sql.NullInt64
+	if origin.TotalCost.Valid && origin.Quantity > 0 {
+		totalCost = sql.NullInt64{Int64: origin.TotalCost.Int64 * outcome.Quantity / origin.Quantity, Valid: true}
+	}
+
+	return productHistoryService.RecordProductHistoryMasuk(ctx, q, sqlc.InsertProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   stockReturn.ProductGuid,
+		Quantity:      outcome.Quantity,
+		WarehouseGuid: stockReturn.WarehouseGuid,
+		PegawaiMasuk:  userGUID,
+		CreatedBy:     userGUID,
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockReturn, Valid: true},
+		ReferenceGuid: sql.NullString{String: stockReturn.Guid, Valid: true},
+		BinGuid:       outcome.BinGuid,
+		LotNumber:     origin.LotNumber,
+		ExpiryDate:    origin.ExpiryDate,
+		TotalCost:     totalCost,
+		CustomerGuid:  stockReturn.CustomerGuid,
+		StockStatus:   stockStatus,
+	}, serials)
+}
+
+// scrapStockReturn restocks the goods as damaged and writes them off again under the outcome
+// reason code, available stock is not touched.
+func scrapStockReturn(ctx context.Context, q *sqlc.Queries, stockReturn sqlc.StockReturn, origin sqlc.ProductsHistory, outcome sqlc.InsertStockReturnOutcomeParams, serials []string, userGUID string) (productHistory sqlc.ProductsHistory, err error) {
+	if _, err = restockStockReturn(ctx, q, stockReturn, origin, outcome, serials, constants.StockStatusDamaged, userGUID); err != nil {
+		return
+	}
+
+	return productHistoryService.RecordProductHistoryKeluar(ctx, q, sqlc.InsertKeluarProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   stockReturn.ProductGuid,
+		Quantity:      outcome.Quantity,
+		WarehouseGuid: stockReturn.WarehouseGuid,
+		PegawaiKeluar: userGUID,
+		CreatedBy:     userGUID,
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockReturn, Valid: true},
+		ReferenceGuid: sql.NullString{String: stockReturn.Guid, Valid: true},
+		LotNumber:     origin.LotNumber,
+		ReasonCode:    outcome.ReasonCode,
+		StockStatus:   constants.StockStatusDamaged,
+	}, serials)
+}
+
+// transitionError tells a missing return apart from one whose status does not allow
+// the requested action, since both surface as sql.ErrNoRows from the guarded update.
+func transitionError(ctx context.Context, q *sqlc.Queries, guid string, errUpdate error) (err error) {
+	if !errors.Is(errUpdate, sql.ErrNoRows) {
+		log.FromCtx(ctx).Error(errUpdate, "failed update stock return status")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.GetStockReturn(ctx, guid); err != nil {
+		err = errors.WithStack(httpservice.ErrStockReturnNotFound)
+
+		return
+	}
+
+	err = errors.WithStack(httpservice.ErrInvalidStockReturn)
+
+	return
+}
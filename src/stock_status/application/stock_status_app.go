@@ -0,0 +1,145 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/stock_status/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockStatus(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockStatusService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	stockStatus := e.Group("/stock-status")
+	stockStatus.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock status ok")
+	})
+	stockStatus.Use(mddw.ValidateToken)
+	stockStatus.Use(mddw.ValidateUserHandheldLogin)
+
+	stockStatus.GET("/balance", getStockStatusBalance(svc))
+	stockStatus.POST("/move", moveStockStatusHandheld(svc))
+	stockStatus.POST("/list", listStockStatusMove(svc))
+	stockStatus.GET("/:guid", getStockStatusMove(svc))
+
+	stockStatusBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "stock-status")
+	stockStatusBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock status ok")
+	})
+	stockStatusBO.Use(mddw.ValidateToken)
+	stockStatusBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockStatusBO.GET("/balance", getStockStatusBalance(svc))
+	stockStatusBO.POST("/move", moveStockStatusBackoffice(svc))
+	stockStatusBO.POST("/list", listStockStatusMove(svc))
+	stockStatusBO.GET("/:guid", getStockStatusMove(svc))
+}
+
+func getStockStatusBalance(svc *service.StockStatusService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ReadStockStatusBalancePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		balance, listHeld, reserved, err := svc.GetStockStatusBalance(ctx.Request().Context(), request.ProductID, request.WarehouseID)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockStatusBalance(balance, listHeld, reserved), nil)
+	}
+}
+
+func moveStockStatusHandheld(svc *service.StockStatusService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		return moveStockStatus(ctx, svc, userHandheld.Guid)
+	}
+}
+
+func moveStockStatusBackoffice(svc *service.StockStatusService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		return moveStockStatus(ctx, svc, userBackoffice.Guid)
+	}
+}
+
+func moveStockStatus(ctx echo.Context, svc *service.StockStatusService, userGUID string) error {
+	var request payload.MoveStockStatusPayload
+	if err := ctx.Bind(&request); err != nil {
+		log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+		return errors.WithStack(httpservice.ErrBadRequest)
+	}
+
+	// Validate request
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	data, err := svc.MoveStockStatus(ctx.Request().Context(), request.ToEntity(userGUID))
+	if err != nil {
+		return err
+	}
+
+	return httpservice.ResponseData(ctx, payload.ToPayloadStockStatusMove(data), nil)
+}
+
+func listStockStatusMove(svc *service.StockStatusService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockStatusMovePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockStatusMove(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockStatusMove(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getStockStatusMove(svc *service.StockStatusService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetStockStatusMove(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockStatusMove(data), nil)
+	}
+}
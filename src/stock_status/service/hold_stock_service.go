@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// The stock balance holds the on-hand quantity in every status. Only the quantities held
+// in quarantine, damaged or on hold are kept per status, whatever is left is available.
+// The status is tracked per product and warehouse, bin, lot and serial balances do not
+// carry it.
+
+// HoldStock adds to the quantity held in a status, stock coming in as available needs no
+// bookkeeping.
+func HoldStock(ctx context.Context, q *sqlc.Queries, productGUID string, warehouseGUID string, stockStatus string, quantity int64) (err error) {
+	if stockStatus == constants.StockStatusAvailable {
+		return
+	}
+
+	if _, err = q.IncreaseStockStatusBalance(ctx, sqlc.IncreaseStockStatusBalanceParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+		StockStatus:   stockStatus,
+		Quantity:      quantity,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed increase stock status balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ReleaseHeldStock takes a quantity out of a held status, refusing more than is held.
+func ReleaseHeldStock(ctx context.Context, q *sqlc.Queries, productGUID string, warehouseGUID string, stockStatus string, quantity int64) (err error) {
+	if stockStatus == constants.StockStatusAvailable {
+		return
+	}
+
+	if _, err = q.DecreaseStockStatusBalance(ctx, sqlc.DecreaseStockStatusBalanceParams{
+		Quantity:      quantity,
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+		StockStatus:   stockStatus,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Wrapf(httpservice.ErrInsufficientStock, "not enough stock in status %s", stockStatus)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed decrease stock status balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// GetHeldStock returns the quantity of a product in a warehouse that is not available.
+func GetHeldStock(ctx context.Context, q *sqlc.Queries, productGUID string, warehouseGUID string) (held int64, err error) {
+	held, err = q.GetSumHeldStockStatusBalance(ctx, sqlc.GetSumHeldStockStatusBalanceParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get sum held stock status balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
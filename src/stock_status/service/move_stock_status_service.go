@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// MoveStockStatus moves on-hand stock from one status to another without changing the stock
+// balance. Stock leaving available must not be reserved for pending orders. The stock balance
+// row is locked first, like reservations do, so both are checked against the same quantity.
+func (s *StockStatusService) MoveStockStatus(ctx context.Context, request sqlc.InsertStockStatusMoveParams) (stockStatusMove sqlc.GetStockStatusMoveRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	balance, err := q.GetStockBalanceForUpdate(ctx, sqlc.GetStockBalanceForUpdateParams{
+		ProductGuid:   request.ProductGuid,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.Wrapf(httpservice.ErrInsufficientStock, "product %s has no stock in warehouse %s", request.ProductGuid, request.WarehouseGuid)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if request.FromStatus == constants.StockStatusAvailable {
+		if err = validateUnreservedStock(ctx, q, balance, request.Quantity); err != nil {
+			return
+		}
+	}
+
+	if err = ReleaseHeldStock(ctx, q, request.ProductGuid, request.WarehouseGuid, request.FromStatus, request.Quantity); err != nil {
+		return
+	}
+
+	if err = HoldStock(ctx, q, request.ProductGuid, request.WarehouseGuid, request.ToStatus, request.Quantity); err != nil {
+		return
+	}
+
+	if _, err = q.InsertStockStatusMove(ctx, request); err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert stock status move")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	stockStatusMove, err = q.GetStockStatusMove(ctx, request.Guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get stock status move")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// validateUnreservedStock checks that the quantity is available and not reserved.
+func validateUnreservedStock(ctx context.Context, q *sqlc.Queries, balance sqlc.StockBalance, quantity int64) (err error) {
+	held, err := GetHeldStock(ctx, q, balance.ProductGuid, balance.WarehouseGuid)
+	if err != nil {
+		return
+	}
+
+	reserved, err := q.GetSumActiveStockReservation(ctx, sqlc.GetSumActiveStockReservationParams{
+		ProductGuid:   balance.ProductGuid,
+		WarehouseGuid: balance.WarehouseGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get sum active stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if available := balance.Quantity - held - reserved; available < quantity {
+		err = errors.Wrapf(httpservice.ErrInsufficientStock, "product %s has %d available in warehouse %s", balance.ProductGuid, available, balance.WarehouseGuid)
+
+		return
+	}
+
+	return
+}
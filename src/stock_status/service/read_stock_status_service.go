@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetStockStatusBalance returns the on-hand stock of a product in a warehouse with the
+// quantities held per status and the active reservations. A product never stocked in the
+// warehouse reads as empty.
+func (s *StockStatusService) GetStockStatusBalance(ctx context.Context, productGUID string, warehouseGUID string) (balance sqlc.StockBalance, listHeld []sqlc.StockStatusBalance, reserved int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	balance, err = q.GetStockBalance(ctx, sqlc.GetStockBalanceParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.FromCtx(ctx).Error(err, "failed get stock balance")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		balance = sqlc.StockBalance{
+			ProductGuid:   productGUID,
+			WarehouseGuid: warehouseGUID,
+		}
+	}
+
+	listHeld, err = q.ListStockStatusBalance(ctx, sqlc.ListStockStatusBalanceParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock status balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	reserved, err = q.GetSumActiveStockReservation(ctx, sqlc.GetSumActiveStockReservationParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get sum active stock reservation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockStatusService) ListStockStatusMove(ctx context.Context, request sqlc.ListStockStatusMoveParams) (listStockStatusMove []sqlc.ListStockStatusMoveRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountStockStatusMove(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listStockStatusMove, err = q.ListStockStatusMove(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock status move")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockStatusService) GetStockStatusMove(ctx context.Context, guid string) (stockStatusMove sqlc.GetStockStatusMoveRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	stockStatusMove, err = q.GetStockStatusMove(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockStatusMoveNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock status move")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockStatusService) getCountStockStatusMove(ctx context.Context, q *sqlc.Queries, request sqlc.ListStockStatusMoveParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountStockStatusMoveParams{
+		SetProduct:     request.SetProduct,
+		ProductGuid:    request.ProductGuid,
+		SetWarehouse:   request.SetWarehouse,
+		WarehouseGuid:  request.WarehouseGuid,
+		SetStockStatus: request.SetStockStatus,
+		StockStatus:    request.StockStatus,
+		SetReasonCode:  request.SetReasonCode,
+		ReasonCode:     request.ReasonCode,
+	}
+
+	totalData, err = q.GetCountStockStatusMove(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock status move")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
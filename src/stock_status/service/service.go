@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockStatusService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockStatusService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockStatusService {
+	return &StockStatusService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
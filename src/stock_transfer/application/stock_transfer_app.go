@@ -0,0 +1,202 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/stock_transfer/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockTransfer(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockTransferService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	stockTransfer := e.Group("/stock-transfer")
+	stockTransfer.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock transfer ok")
+	})
+	stockTransfer.Use(mddw.ValidateToken)
+	stockTransfer.Use(mddw.ValidateUserHandheldLogin)
+
+	stockTransfer.POST("/list", listStockTransfer(svc))
+	stockTransfer.GET("/:guid", getStockTransfer(svc))
+	stockTransfer.POST("/dispatch/:guid", dispatchStockTransferHandheld(svc))
+	stockTransfer.POST("/receive/:guid", receiveStockTransferHandheld(svc))
+
+	stockTransferBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "stock-transfer")
+	stockTransferBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock transfer ok")
+	})
+	stockTransferBO.Use(mddw.ValidateToken)
+	stockTransferBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockTransferBO.POST("/create", createStockTransfer(svc))
+	stockTransferBO.POST("/list", listStockTransfer(svc))
+	stockTransferBO.GET("/:guid", getStockTransfer(svc))
+	stockTransferBO.POST("/dispatch/:guid", dispatchStockTransferBackoffice(svc))
+	stockTransferBO.POST("/cancel/:guid", cancelStockTransfer(svc))
+}
+
+func createStockTransfer(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.InsertStockTransferPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		requestTransfer := request.ToEntity(userBackoffice.Guid)
+
+		data, listItem, err := svc.CreateStockTransfer(ctx.Request().Context(), requestTransfer, request.ToEntityItems(requestTransfer.Guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockTransfer(data, listItem), nil)
+	}
+}
+
+func listStockTransfer(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListStockTransferPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListStockTransfer(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListStockTransfer(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getStockTransfer(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, listItem, err := svc.GetStockTransfer(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockTransfer(data, listItem), nil)
+	}
+}
+
+func dispatchStockTransferHandheld(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		return dispatchStockTransfer(ctx, svc, userHandheld.Guid)
+	}
+}
+
+func dispatchStockTransferBackoffice(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		return dispatchStockTransfer(ctx, svc, userBackoffice.Guid)
+	}
+}
+
+func dispatchStockTransfer(ctx echo.Context, svc *service.StockTransferService, userGUID string) error {
+	guid := ctx.Param("guid")
+	if guid == "" {
+		return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+	}
+
+	var request payload.DispatchStockTransferPayload
+	if err := ctx.Bind(&request); err != nil {
+		log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+		return errors.WithStack(httpservice.ErrBadRequest)
+	}
+
+	// Validate request
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	data, listItem, err := svc.DispatchStockTransfer(ctx.Request().Context(), guid, request.ToEntity(), userGUID)
+	if err != nil {
+		return err
+	}
+
+	return httpservice.ResponseData(ctx, payload.ToPayloadStockTransfer(data, listItem), nil)
+}
+
+func receiveStockTransferHandheld(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ReceiveStockTransferPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userHandheld := ctx.Get(constants.MddwUserHandheld).(sqlc.UserHandheld)
+
+		data, listItem, err := svc.ReceiveStockTransfer(ctx.Request().Context(), guid, request.ToEntity(), userHandheld.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockTransfer(data, listItem), nil)
+	}
+}
+
+func cancelStockTransfer(svc *service.StockTransferService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userBackoffice := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		data, listItem, err := svc.CancelStockTransfer(ctx.Request().Context(), guid, userBackoffice.Guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockTransfer(data, listItem), nil)
+	}
+}
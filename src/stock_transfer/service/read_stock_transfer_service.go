@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *StockTransferService) ListStockTransfer(ctx context.Context, request sqlc.ListStockTransferParams) (listStockTransfer []sqlc.ListStockTransferRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountStockTransfer(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listStockTransfer, err = q.ListStockTransfer(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock transfer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *StockTransferService) GetStockTransfer(ctx context.Context, guid string) (stockTransfer sqlc.GetStockTransferRow, listItem []sqlc.ListStockTransferItemRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	return getStockTransferWithItems(ctx, q, guid)
+}
+
+func (s *StockTransferService) getCountStockTransfer(ctx context.Context, q *sqlc.Queries, request sqlc.ListStockTransferParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountStockTransferParams{
+		SetTransferCode:          request.SetTransferCode,
+		TransferCode:             request.TransferCode,
+		SetStatus:                request.SetStatus,
+		Status:                   request.Status,
+		SetSourceWarehouse:       request.SetSourceWarehouse,
+		SourceWarehouseGuid:      request.SourceWarehouseGuid,
+		SetDestinationWarehouse:  request.SetDestinationWarehouse,
+		DestinationWarehouseGuid: request.DestinationWarehouseGuid,
+	}
+
+	totalData, err = q.GetCountStockTransfer(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list stock transfer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func getStockTransferWithItems(ctx context.Context, q *sqlc.Queries, guid string) (stockTransfer sqlc.GetStockTransferRow, listItem []sqlc.ListStockTransferItemRow, err error) {
+	stockTransfer, err = q.GetStockTransfer(ctx, guid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrStockTransferNotFound)
+
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get stock transfer")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listItem, err = q.ListStockTransferItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock transfer item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockTransferService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockTransferService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockTransferService {
+	return &StockTransferService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,454 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"time"
+)
+
+// DispatchStockTransfer moves a draft transfer to in_transit and books every line out of
+// the source warehouse in the same transaction. serials maps item guid to the serial
+// numbers scanned for serialized products.
+func (s *StockTransferService) DispatchStockTransfer(ctx context.Context, guid string, serials map[string][]string, userGUID string) (stockTransfer sqlc.GetStockTransferRow, listItem []sqlc.ListStockTransferItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	transfer, err := q.DispatchStockTransfer(ctx, sqlc.DispatchStockTransferParams{
+		DispatchedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:         guid,
+	})
+	if err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	listItem, err = q.ListStockTransferItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock transfer item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for i := range listItem {
+		if err = dispatchStockTransferItem(ctx, q, transfer.SourceWarehouseGuid, guid, listItem[i], serials[listItem[i].Guid], userGUID); err != nil {
+			return
+		}
+	}
+
+	stockTransfer, listItem, err = getStockTransferWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ReceiveStockTransfer closes an in_transit transfer and books the received quantities into
+// the destination warehouse. receivedQuantity maps item guid to the counted quantity; lines
+// that are not listed are received in full. Serialized lines travel with the serials scanned
+// at dispatch and can only be received in full. Received goods keep the lots, expiry dates
+// and cost they left the source warehouse with.
+func (s *StockTransferService) ReceiveStockTransfer(ctx context.Context, guid string, receivedQuantity map[string]int64, userGUID string) (stockTransfer sqlc.GetStockTransferRow, listItem []sqlc.ListStockTransferItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	transfer, err := q.ReceiveStockTransfer(ctx, sqlc.ReceiveStockTransferParams{
+		ReceivedBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:       guid,
+	})
+	if err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	listItem, err = q.ListStockTransferItem(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock transfer item")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	itemFound := 0
+
+	for i := range listItem {
+		quantity, ok := receivedQuantity[listItem[i].Guid]
+		if ok {
+			itemFound++
+		} else {
+			quantity = listItem[i].Quantity
+		}
+
+		if _, err = q.UpdateStockTransferItemReceivedQuantity(ctx, sqlc.UpdateStockTransferItemReceivedQuantityParams{
+			ReceivedQuantity:  sql.NullInt64{Int64: quantity, Valid: true},
+			Guid:              listItem[i].Guid,
+			StockTransferGuid: guid,
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed update stock transfer item received quantity")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		var serials []string
+
+		serials, err = dispatchedSerials(ctx, q, guid, listItem[i].ProductGuid)
+		if err != nil {
+			return
+		}
+
+		if len(serials) > 0 && int64(len(serials)) != quantity {
+			err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: serialized item %s must be received in full", listItem[i].Guid)
+
+			return
+		}
+
+		if quantity == 0 {
+			continue
+		}
+
+		if err = receiveStockTransferItem(ctx, q, transfer.DestinationWarehouseGuid, guid, listItem[i].ProductGuid, quantity, serials, userGUID); err != nil {
+			return
+		}
+	}
+
+	if itemFound != len(receivedQuantity) {
+		err = errors.Wrap(httpservice.ErrBadRequest, "bad request: item does not belong to this stock transfer")
+
+		return
+	}
+
+	stockTransfer, listItem, err = getStockTransferWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// CancelStockTransfer cancels a draft or in_transit transfer. Goods that were already
+// dispatched are booked back into the source warehouse at the cost they left with.
+func (s *StockTransferService) CancelStockTransfer(ctx context.Context, guid string, userGUID string) (stockTransfer sqlc.GetStockTransferRow, listItem []sqlc.ListStockTransferItemRow, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	current, listItem, err := getStockTransferWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if current.Status != constants.StockTransferStatusDraft && current.Status != constants.StockTransferStatusInTransit {
+		err = errors.WithStack(httpservice.ErrInvalidStockTransfer)
+
+		return
+	}
+
+	transfer, err := q.CancelStockTransfer(ctx, sqlc.CancelStockTransferParams{
+		CancelledBy: sql.NullString{String: userGUID, Valid: true},
+		Guid:        guid,
+		Status:      current.Status,
+	})
+	if err != nil {
+		err = transitionError(ctx, q, guid, err)
+
+		return
+	}
+
+	if current.Status == constants.StockTransferStatusInTransit {
+		for i := range listItem {
+			var serials []string
+
+			serials, err = dispatchedSerials(ctx, q, guid, listItem[i].ProductGuid)
+			if err != nil {
+				return
+			}
+
+			if err = receiveStockTransferItem(ctx, q, transfer.SourceWarehouseGuid, guid, listItem[i].ProductGuid, listItem[i].Quantity, serials, userGUID); err != nil {
+				return
+			}
+		}
+	}
+
+	stockTransfer, listItem, err = getStockTransferWithItems(ctx, q, guid)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// transitionError tells a missing transfer apart from one whose status does not allow
+// the requested action, since both surface as sql.ErrNoRows from the guarded update.
+func transitionError(ctx context.Context, q *sqlc.Queries, guid string, errUpdate error) (err error) {
+	if !errors.Is(errUpdate, sql.ErrNoRows) {
+		log.FromCtx(ctx).Error(errUpdate, "failed update stock transfer status")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if _, err = q.GetStockTransfer(ctx, guid); err != nil {
+		err = errors.WithStack(httpservice.ErrStockTransferNotFound)
+
+		return
+	}
+
+	err = errors.WithStack(httpservice.ErrInvalidStockTransfer)
+
+	return
+}
+
+// dispatchStockTransferItem books a transfer line out of the source warehouse. Lot tracked
+// stock leaves first-expiry-first-out with one movement per lot, so the lots can be received
+// again on the other side. Serialized lines leave as one movement with their scanned serials.
+func dispatchStockTransferItem(ctx context.Context, q *sqlc.Queries, warehouseGUID string, guid string, item sqlc.ListStockTransferItemRow, serials []string, userGUID string) (err error) {
+	request := sqlc.InsertKeluarProductsHistoryParams{
+		Guid:          utility.GenerateGoogleUUID(),
+		ProductGuid:   item.ProductGuid,
+		Quantity:      item.Quantity,
+		WarehouseGuid: warehouseGUID,
+		PegawaiKeluar: userGUID,
+		CreatedBy:     userGUID,
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockTransfer, Valid: true},
+		ReferenceGuid: sql.NullString{String: guid, Valid: true},
+	}
+
+	if len(serials) > 0 {
+		_, err = productHistoryService.RecordProductHistoryKeluar(ctx, q, request, serials)
+		return
+	}
+
+	listLot, err := q.ListStockLotBalanceFEFOForUpdate(ctx, sqlc.ListStockLotBalanceFEFOForUpdateParams{
+		ProductGuid:   item.ProductGuid,
+		WarehouseGuid: warehouseGUID,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock lot balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	for _, lot := range productHistoryService.AllocateLotFEFO(listLot, item.Quantity, time.Now()) {
+		lotRequest := request
+		lotRequest.Guid = utility.GenerateGoogleUUID()
+		lotRequest.Quantity = lot.Quantity
+		lotRequest.LotNumber = sql.NullString{String: lot.LotNumber, Valid: true}
+		lotRequest.ExpiryDate = lot.ExpiryDate
+
+		if _, err = productHistoryService.RecordProductHistoryKeluar(ctx, q, lotRequest, nil); err != nil {
+			return
+		}
+
+		request.Quantity -= lot.Quantity
+	}
+
+	// Whatever the lots did not cover leaves as untracked stock
+	if request.Quantity > 0 {
+		_, err = productHistoryService.RecordProductHistoryKeluar(ctx, q, request, nil)
+	}
+
+	return
+}
+
+// receiveStockTransferItem books quantity units of a dispatched transfer line into a warehouse.
+// The dispatched lots are filled earliest expiry first and the rest comes in untracked, every
+// part valued at the average cost the line was dispatched with.
+func receiveStockTransferItem(ctx context.Context, q *sqlc.Queries, warehouseGUID string, guid string, productGUID string, quantity int64, serials []string, userGUID string) (err error) {
+	listDispatchedLot, err := q.ListLotProductsHistoryByReference(ctx, sqlc.ListLotProductsHistoryByReferenceParams{
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockTransfer, Valid: true},
+		ReferenceGuid: sql.NullString{String: guid, Valid: true},
+		ProductGuid:   productGUID,
+		HistoryType:   constants.ProductHistoryTypeKeluar,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list dispatched lot")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listLot := make([]sqlc.StockLotBalance, len(listDispatchedLot))
+	for i := range listDispatchedLot {
+		listLot[i] = sqlc.StockLotBalance{
+			ProductGuid:   productGUID,
+			WarehouseGuid: warehouseGUID,
+			LotNumber:     listDispatchedLot[i].LotNumber,
+			ExpiryDate:    listDispatchedLot[i].ExpiryDate,
+			Quantity:      listDispatchedLot[i].Quantity,
+		}
+	}
+
+	request := sqlc.InsertProductsHistoryParams{
+		ProductGuid:   productGUID,
+		WarehouseGuid: warehouseGUID,
+		PegawaiMasuk:  userGUID,
+		CreatedBy:     userGUID,
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockTransfer, Valid: true},
+		ReferenceGuid: sql.NullString{String: guid, Valid: true},
+	}
+
+	// Dispatched lots come back in whatever their expiry, the goods are already on the way
+	for _, lot := range productHistoryService.AllocateLotFEFO(listLot, quantity, time.Time{}) {
+		lotRequest := request
+		lotRequest.Quantity = lot.Quantity
+		lotRequest.LotNumber = sql.NullString{String: lot.LotNumber, Valid: true}
+		lotRequest.ExpiryDate = lot.ExpiryDate
+
+		if err = recordStockTransferMasuk(ctx, q, lotRequest, nil); err != nil {
+			return
+		}
+
+		quantity -= lot.Quantity
+	}
+
+	if quantity > 0 {
+		request.Quantity = quantity
+
+		err = recordStockTransferMasuk(ctx, q, request, serials)
+	}
+
+	return
+}
+
+// recordStockTransferMasuk books one part of a transfer line in at its dispatched cost.
+func recordStockTransferMasuk(ctx context.Context, q *sqlc.Queries, request sqlc.InsertProductsHistoryParams, serials []string) (err error) {
+	request.Guid = utility.GenerateGoogleUUID()
+
+	request.TotalCost, err = dispatchedCost(ctx, q, request.ReferenceGuid.String, request.ProductGuid, request.Quantity)
+	if err != nil {
+		return
+	}
+
+	_, err = productHistoryService.RecordProductHistoryMasuk(ctx, q, request, serials)
+
+	return
+}
+
+// dispatchedSerials returns the serial numbers that left the source warehouse for a transfer line.
+func dispatchedSerials(ctx context.Context, q *sqlc.Queries, guid string, productGUID string) (serials []string, err error) {
+	serials, err = q.ListSerialNumberByReference(ctx, sqlc.ListSerialNumberByReferenceParams{
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockTransfer, Valid: true},
+		ReferenceGuid: sql.NullString{String: guid, Valid: true},
+		ProductGuid:   productGUID,
+		HistoryType:   constants.ProductHistoryTypeKeluar,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list dispatched serial number")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// dispatchedCost values quantity units of a transfer line at the average cost they were
+// dispatched with. Without a costed dispatch the receiving warehouse average cost applies.
+func dispatchedCost(ctx context.Context, q *sqlc.Queries, guid string, productGUID string, quantity int64) (totalCost sql.NullInt64, err error) {
+	dispatched, err := q.GetSumProductsHistoryByReference(ctx, sqlc.GetSumProductsHistoryByReferenceParams{
+		ReferenceType: sql.NullString{String: constants.ProductHistoryReferenceStockTransfer, Valid: true},
+		ReferenceGuid: sql.NullString{String: guid, Valid: true},
+		ProductGuid:   productGUID,
+		HistoryType:   constants.ProductHistoryTypeKeluar,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get dispatched cost")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if dispatched.Quantity <= 0 {
+		return
+	}
+
+	totalCost = sql.NullInt64{Int64: dispatched.TotalCost * quantity / dispatched.Quantity, Valid: true}
+
+	return
+}
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	"github.com/wit-id/blueprint-backend-go/src/stock_valuation/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteStockValuation(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewStockValuationService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	stockValuationBO := e.Group(cfg.GetString(constants.ConfigPrefixRoutesBackoffice) + "stock-valuation")
+	stockValuationBO.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "stock valuation ok")
+	})
+	stockValuationBO.Use(mddw.ValidateToken)
+	stockValuationBO.Use(mddw.ValidateUserBackofficeLogin)
+
+	stockValuationBO.GET("/report", readStockValuationReport(svc))
+}
+
+func readStockValuationReport(svc *service.StockValuationService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ReadStockValuationReportPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		requestReport := request.ToEntity()
+
+		listData, total, err := svc.ListStockValuationAsOf(ctx.Request().Context(), requestReport)
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(total.TotalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadStockValuationReport(requestReport.AsOf, total.TotalValue, listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(total.TotalData))
+	}
+}
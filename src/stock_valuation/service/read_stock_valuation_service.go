@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ListStockValuationAsOf sums the quantity and value of every movement booked up to the given
+// time, per warehouse and product. Outbound movements count at their cost of goods sold, so the
+// figures match what the valuation engine held at that moment. The total counts and values
+// every row, not only the requested page.
+func (s *StockValuationService) ListStockValuationAsOf(ctx context.Context, request sqlc.ListStockValuationAsOfParams) (listValuation []sqlc.ListStockValuationAsOfRow, total sqlc.GetTotalStockValuationAsOfRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if request.SetWarehouse {
+		if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+			log.FromCtx(ctx).Error(err, "failed get warehouse")
+			err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+			return
+		}
+	}
+
+	total, err = q.GetTotalStockValuationAsOf(ctx, sqlc.GetTotalStockValuationAsOfParams{
+		AsOf:          request.AsOf,
+		SetWarehouse:  request.SetWarehouse,
+		WarehouseGuid: request.WarehouseGuid,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total stock valuation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listValuation, err = q.ListStockValuationAsOf(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock valuation")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type StockValuationService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewStockValuationService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *StockValuationService {
+	return &StockValuationService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
@@ -0,0 +1,256 @@
+package application
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/src/middleware"
+	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/src/supplier/service"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"math"
+	"net/http"
+)
+
+func AddRouteSupplier(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
+	svc := service.NewSupplierService(s.GetDB(), cfg)
+
+	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
+
+	supplier := e.Group("/supplier")
+	supplier.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "supplier ok")
+	})
+
+	supplier.POST("", listSupplier(svc), mddw.ValidateToken)
+	supplier.GET("/:guid", getSupplier(svc), mddw.ValidateToken)
+	supplier.POST("/create", createSupplier(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	supplier.PUT("/:guid", updateSupplier(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	supplier.DELETE("/:guid", deleteSupplier(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	supplier.GET("/reactive/:guid", reactiveSupplier(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+
+	supplier.GET("/:guid/products", listSupplierProduct(svc), mddw.ValidateToken)
+	supplier.PUT("/:guid/products/:product_guid", upsertSupplierProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	supplier.DELETE("/:guid/products/:product_guid", deleteSupplierProduct(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	supplier.GET("/product/:product_guid", listProductSupplier(svc), mddw.ValidateToken)
+}
+
+func createSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.RegisterSupplierPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.CreateSupplier(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSupplier(data), nil)
+	}
+}
+
+func updateSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.UpdateSupplierPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.UpdateSupplier(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSupplier(data), nil)
+	}
+}
+
+func deleteSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.DeleteSupplier(ctx.Request().Context(), guid, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func reactiveSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.ReactiveSupplier(ctx.Request().Context(), guid, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func listSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ListSupplierPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListSupplier(ctx.Request().Context(), request.ToEntity())
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListSupplier(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetSupplier(ctx.Request().Context(), guid)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadSupplier(data), nil)
+	}
+}
+
+func listSupplierProduct(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListProductSupplierPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListProductSupplierBySupplier(ctx.Request().Context(), request.ToEntity(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListProductSupplierBySupplier(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func upsertSupplierProduct(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		productGUID := ctx.Param("product_guid")
+		if guid == "" || productGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.UpsertProductSupplierPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.UpsertProductSupplier(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid, productGUID))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadProductSupplier(data), nil)
+	}
+}
+
+func deleteSupplierProduct(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		productGUID := ctx.Param("product_guid")
+		if guid == "" || productGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		err := svc.DeleteProductSupplier(ctx.Request().Context(), guid, productGUID)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func listProductSupplier(svc *service.SupplierService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		productGUID := ctx.Param("product_guid")
+		if productGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		listData, err := svc.ListProductSupplierByProduct(ctx.Request().Context(), productGUID)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadListProductSupplierByProduct(listData), nil)
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// UpsertProductSupplier links a product to an active supplier, linking it again updates the
+// supplier sku and lead time.
+func (s *SupplierService) UpsertProductSupplier(ctx context.Context, request sqlc.UpsertProductSupplierParams) (productSupplier sqlc.ProductSupplier, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	supplier, err := q.GetSupplier(ctx, request.SupplierGuid)
+	if err != nil || supplier.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get supplier", "supplier_guid", request.SupplierGuid)
+		err = errors.WithStack(httpservice.ErrSupplierNotFound)
+
+		return
+	}
+
+	product, err := q.GetProduct(ctx, request.ProductGuid)
+	if err != nil || product.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get product", "product_guid", request.ProductGuid)
+		err = errors.WithStack(httpservice.ErrProductNotFound)
+
+		return
+	}
+
+	productSupplier, err = q.UpsertProductSupplier(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed upsert product supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *SupplierService) DeleteProductSupplier(ctx context.Context, supplierGUID string, productGUID string) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.DeleteProductSupplier(ctx, sqlc.DeleteProductSupplierParams{
+		ProductGuid:  productGUID,
+		SupplierGuid: supplierGUID,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = errors.WithStack(httpservice.ErrProductSupplierNotFound)
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed delete product supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *SupplierService) ListProductSupplierBySupplier(ctx context.Context, request sqlc.ListProductSupplierBySupplierParams) (listProductSupplier []sqlc.ListProductSupplierBySupplierRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	totalData, err = q.GetCountProductSupplierBySupplier(ctx, request.SupplierGuid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list product supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listProductSupplier, err = q.ListProductSupplierBySupplier(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// ListProductSupplierByProduct lists every supplier a product can be sourced from, inactive
+// suppliers included so purchasing can see why one is no longer offered.
+func (s *SupplierService) ListProductSupplierByProduct(ctx context.Context, productGUID string) (listProductSupplier []sqlc.ListProductSupplierByProductRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	listProductSupplier, err = q.ListProductSupplierByProduct(ctx, productGUID)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list product supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *SupplierService) ListSupplier(ctx context.Context, request sqlc.ListSupplierParams) (listSupplier []sqlc.ListSupplierRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	// Get Total data
+	totalData, err = s.getCountSupplier(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listSupplier, err = q.ListSupplier(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *SupplierService) GetSupplier(ctx context.Context, guid string) (supplier sqlc.GetSupplierRow, err error) {
+	q := sqlc.New(s.mainDB)
+
+	supplier, err = q.GetSupplier(ctx, guid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get supplier")
+		err = errors.WithStack(httpservice.ErrSupplierNotFound)
+
+		return
+	}
+
+	return
+}
+
+func (s *SupplierService) getCountSupplier(ctx context.Context, q *sqlc.Queries, request sqlc.ListSupplierParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountSupplierParams{
+		SetName:         request.SetName,
+		Name:            request.Name,
+		SetSupplierCode: request.SetSupplierCode,
+		SupplierCode:    request.SupplierCode,
+		SetActive:       request.SetActive,
+		Active:          request.Active,
+	}
+
+	totalData, err = q.GetCountSupplier(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list supplier")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+)
+
+type SupplierService struct {
+	mainDB *sql.DB
+	cfg    config.KVStore
+}
+
+func NewSupplierService(
+	mainDB *sql.DB,
+	cfg config.KVStore,
+) *SupplierService {
+	return &SupplierService{
+		mainDB: mainDB,
+		cfg:    cfg,
+	}
+}
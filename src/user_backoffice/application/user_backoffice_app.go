@@ -1,6 +1,8 @@
 package application
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"net/http"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/wit-id/blueprint-backend-go/common/constants"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	exportService "github.com/wit-id/blueprint-backend-go/src/export/service"
 	"github.com/wit-id/blueprint-backend-go/src/middleware"
 	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
 	"github.com/wit-id/blueprint-backend-go/src/user_backoffice/service"
@@ -19,6 +23,7 @@ import (
 
 func AddRouteUserBackoffice(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
 	svc := service.NewUserBackofficeService(s.GetDB(), cfg)
+	exportSvc := exportService.NewExportService(s.GetDB(), cfg)
 
 	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
 
@@ -33,6 +38,7 @@ func AddRouteUserBackoffice(s *httpservice.Service, cfg config.KVStore, e *echo.
 	userBackoffice.PUT("/is-active/:guid", updateIsActiveUserBackoffice(svc))
 	userBackoffice.DELETE("/:guid", deleteUserBackoffice(svc))
 	userBackoffice.POST("/list", listUserBackoffice(svc))
+	userBackoffice.POST("/export", exportUserBackoffice(svc, exportSvc, cfg))
 	userBackoffice.GET("/:guid", getUserBackoffice(svc))
 
 	userBackofficeProfile := userBackoffice.Group("/profile")
@@ -155,6 +161,49 @@ func listUserBackoffice(svc *service.UserBackofficeService) echo.HandlerFunc {
 	}
 }
 
+func exportUserBackoffice(svc *service.UserBackofficeService, exportSvc *exportService.ExportService, cfg config.KVStore) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ExportUserBackofficePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+		listRequest := request.ToEntity()
+
+		totalData, err := svc.GetCountUserBackoffice(ctx.Request().Context(), listRequest)
+		if err != nil {
+			return err
+		}
+
+		exportRequest := payload.ToEntityExportFile(constants.ExportTypeUserBackoffice, request.Format, totalData, userData)
+
+		content, exportFile, err := exportSvc.Export(ctx.Request().Context(), exportRequest, listRequest, payload.ExportHeaderUserBackoffice, func(renderCtx context.Context, writer utility.ExportWriter) error {
+			return svc.ExportUserBackoffice(renderCtx, listRequest, func(listUserBackoffice []sqlc.ListUserBackofficeRow) error {
+				return utility.WriteExportRows(writer, payload.ToExportRowsUserBackoffice(listUserBackoffice))
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		// A large export is rendered in the background, answer with where to pick it up
+		if exportFile.Guid != "" {
+			return httpservice.ResponseData(ctx, payload.ToPayloadExportFile(exportFile, cfg.GetString(constants.ConfigPrefixRoutesBackoffice)), nil)
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", exportRequest.FileName))
+
+		return ctx.Blob(http.StatusOK, utility.ExportContentType(request.Format), content)
+	}
+}
+
 func deleteUserBackoffice(svc *service.UserBackofficeService) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		guid := ctx.Param("guid")
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetCountUserBackoffice counts the rows an export of the list filter holds.
+func (s *UserBackofficeService) GetCountUserBackoffice(ctx context.Context, request sqlc.ListUserBackofficeParams) (totalData int64, err error) {
+	return s.getCountUserBackoffice(ctx, sqlc.New(s.mainDB), request)
+}
+
+// ExportUserBackoffice reads every user backoffice matching the list filter, a page of request.LimitData rows
+// at a time, and hands each page to writePage.
+func (s *UserBackofficeService) ExportUserBackoffice(ctx context.Context, request sqlc.ListUserBackofficeParams, writePage func(listUserBackoffice []sqlc.ListUserBackofficeRow) error) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	for request.OffsetPage = 0; ; request.OffsetPage += request.LimitData {
+		listUserBackoffice, errList := q.ListUserBackoffice(ctx, request)
+		if errList != nil {
+			log.FromCtx(ctx).Error(errList, "failed get list user backoffice")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if len(listUserBackoffice) > 0 {
+			if err = writePage(listUserBackoffice); err != nil {
+				return
+			}
+		}
+
+		if len(listUserBackoffice) < int(request.LimitData) {
+			return
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"net/http"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/wit-id/blueprint-backend-go/common/constants"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	exportService "github.com/wit-id/blueprint-backend-go/src/export/service"
 	"github.com/wit-id/blueprint-backend-go/src/middleware"
 	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
 	"github.com/wit-id/blueprint-backend-go/src/user_handheld/service"
@@ -19,6 +23,7 @@ import (
 
 func AddRouteUserHandheld(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
 	svc := service.NewUserHandheldService(s.GetDB(), cfg)
+	exportSvc := exportService.NewExportService(s.GetDB(), cfg)
 
 	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
 	userHandheld := e.Group("/user-handheld")
@@ -45,6 +50,7 @@ func AddRouteUserHandheld(s *httpservice.Service, cfg config.KVStore, e *echo.Ec
 	userHandheldBO.PUT("/is-active/:guid", updateUserHandheldIsActive(svc))
 	userHandheldBO.DELETE("/:guid", deleteUserHandheld(svc))
 	userHandheldBO.POST("/list", listUserHandheld(svc))
+	userHandheldBO.POST("/export", exportUserHandheld(svc, exportSvc, cfg))
 	userHandheldBO.GET("/:guid", getUserHandheld(svc))
 }
 
@@ -223,3 +229,46 @@ func getUserHandheldMyProfile(svc *service.UserHandheldService) echo.HandlerFunc
 		return httpservice.ResponseData(ctx, payload.ToPayloadUserHandheld(data), nil)
 	}
 }
+
+func exportUserHandheld(svc *service.UserHandheldService, exportSvc *exportService.ExportService, cfg config.KVStore) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ExportUserHandheldPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+		listRequest := request.ToEntity()
+
+		totalData, err := svc.GetCountUserHandheld(ctx.Request().Context(), listRequest)
+		if err != nil {
+			return err
+		}
+
+		exportRequest := payload.ToEntityExportFile(constants.ExportTypeUserHandheld, request.Format, totalData, userData)
+
+		content, exportFile, err := exportSvc.Export(ctx.Request().Context(), exportRequest, listRequest, payload.ExportHeaderUserHandheld, func(renderCtx context.Context, writer utility.ExportWriter) error {
+			return svc.ExportUserHandheld(renderCtx, listRequest, func(listUserHandheld []sqlc.UserHandheld) error {
+				return utility.WriteExportRows(writer, payload.ToExportRowsUserHandheld(listUserHandheld))
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		// A large export is rendered in the background, answer with where to pick it up
+		if exportFile.Guid != "" {
+			return httpservice.ResponseData(ctx, payload.ToPayloadExportFile(exportFile, cfg.GetString(constants.ConfigPrefixRoutesBackoffice)), nil)
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", exportRequest.FileName))
+
+		return ctx.Blob(http.StatusOK, utility.ExportContentType(request.Format), content)
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetCountUserHandheld counts the rows an export of the list filter holds.
+func (s *UserHandheldService) GetCountUserHandheld(ctx context.Context, request sqlc.ListUserHandheldParams) (totalData int64, err error) {
+	return s.getCountUserHandheld(ctx, sqlc.New(s.mainDB), request)
+}
+
+// ExportUserHandheld reads every user handheld matching the list filter, a page of request.LimitData rows
+// at a time, and hands each page to writePage.
+func (s *UserHandheldService) ExportUserHandheld(ctx context.Context, request sqlc.ListUserHandheldParams, writePage func(listUserHandheld []sqlc.UserHandheld) error) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	for request.OffsetPage = 0; ; request.OffsetPage += request.LimitData {
+		listUserHandheld, errList := q.ListUserHandheld(ctx, request)
+		if errList != nil {
+			log.FromCtx(ctx).Error(errList, "failed get list user handheld")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if len(listUserHandheld) > 0 {
+			if err = writePage(listUserHandheld); err != nil {
+				return
+			}
+		}
+
+		if len(listUserHandheld) < int(request.LimitData) {
+			return
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package application
 
 import (
+	"context"
+	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"github.com/wit-id/blueprint-backend-go/common/constants"
 	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	exportService "github.com/wit-id/blueprint-backend-go/src/export/service"
 	"github.com/wit-id/blueprint-backend-go/src/middleware"
 	"github.com/wit-id/blueprint-backend-go/src/repository/payload"
 	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
@@ -17,6 +21,7 @@ import (
 
 func AddRouteWarehouse(s *httpservice.Service, cfg config.KVStore, e *echo.Echo) {
 	svc := service.NewWarehouseService(s.GetDB(), cfg)
+	exportSvc := exportService.NewExportService(s.GetDB(), cfg)
 
 	mddw := middleware.NewEnsureToken(s.GetDB(), cfg)
 
@@ -27,10 +32,24 @@ func AddRouteWarehouse(s *httpservice.Service, cfg config.KVStore, e *echo.Echo)
 
 	warehouse.POST("", listWarehouse(svc))
 	warehouse.GET("/:guid", getWarehouse(svc))
+	warehouse.GET("/:guid/stock", listWarehouseStock(svc), mddw.ValidateToken)
 	warehouse.POST("/create", createWarehouse(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	warehouse.POST("/import", importWarehouse(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	warehouse.POST("/export", exportWarehouse(svc, exportSvc, cfg), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	warehouse.PUT("/:guid", updateWarehouse(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	warehouse.DELETE("/:guid", deleteWarehouse(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 	warehouse.GET("/reactive/:guid", reactiveWarehouse(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+
+	warehouse.GET("/:guid/lots/suggest", suggestWarehouseLot(svc), mddw.ValidateToken)
+	warehouse.GET("/:guid/lots/expiring", listExpiringWarehouseLot(svc), mddw.ValidateToken)
+
+	warehouse.GET("/:guid/locations", listWarehouseLocation(svc), mddw.ValidateToken)
+	warehouse.GET("/:guid/locations/:location_guid", getWarehouseLocation(svc), mddw.ValidateToken)
+	warehouse.GET("/:guid/locations/:location_guid/stock", listWarehouseLocationStock(svc), mddw.ValidateToken)
+	warehouse.POST("/:guid/locations", createWarehouseLocation(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	warehouse.PUT("/:guid/locations/:location_guid", updateWarehouseLocation(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	warehouse.DELETE("/:guid/locations/:location_guid", deleteWarehouseLocation(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
+	warehouse.GET("/:guid/locations/reactive/:location_guid", reactiveWarehouseLocation(svc), mddw.ValidateToken, mddw.ValidateUserBackofficeLogin)
 }
 
 func createWarehouse(svc *service.WarehouseService) echo.HandlerFunc {
@@ -55,6 +74,105 @@ func createWarehouse(svc *service.WarehouseService) echo.HandlerFunc {
 	}
 }
 
+func importWarehouse(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ImportPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		fileHeader, err := ctx.FormFile("file")
+		if err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to get import file")
+			return errors.Wrap(httpservice.ErrBadRequest, "bad request: file is required")
+		}
+
+		listRow, err := utility.ReadImportFormFile(fileHeader)
+		if err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		// Validate every row, only the valid ones reach the service
+		rowErrors := make(map[int]error)
+		listLine := make([]int, 0, len(listRow))
+		listWarehouse := make([]sqlc.InsertWarehouseParams, 0, len(listRow))
+
+		for i := range listRow {
+			var warehouse payload.RegisterWarehousePayload
+			if err = warehouse.FromImportRow(listRow[i]); err == nil {
+				err = warehouse.Validate()
+			}
+
+			if err != nil {
+				rowErrors[listRow[i].Line] = err
+				continue
+			}
+
+			listLine = append(listLine, listRow[i].Line)
+			listWarehouse = append(listWarehouse, warehouse.ToEntity(userData))
+		}
+
+		listError, err := svc.ImportWarehouse(ctx.Request().Context(), listWarehouse, request.DryRun)
+		if err != nil {
+			return err
+		}
+
+		for i := range listError {
+			if listError[i] != nil {
+				rowErrors[listLine[i]] = listError[i]
+			}
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadImport(request.DryRun, len(listRow), rowErrors), nil)
+	}
+}
+
+func exportWarehouse(svc *service.WarehouseService, exportSvc *exportService.ExportService, cfg config.KVStore) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		var request payload.ExportWarehousePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+		listRequest := request.ToEntity()
+
+		totalData, err := svc.GetCountWarehouse(ctx.Request().Context(), listRequest)
+		if err != nil {
+			return err
+		}
+
+		exportRequest := payload.ToEntityExportFile(constants.ExportTypeWarehouse, request.Format, totalData, userData)
+
+		content, exportFile, err := exportSvc.Export(ctx.Request().Context(), exportRequest, listRequest, payload.ExportHeaderWarehouse, func(renderCtx context.Context, writer utility.ExportWriter) error {
+			return svc.ExportWarehouse(renderCtx, listRequest, func(listWarehouse []sqlc.ListWarehouseRow) error {
+				return utility.WriteExportRows(writer, payload.ToExportRowsWarehouse(listWarehouse))
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		// A large export is rendered in the background, answer with where to pick it up
+		if exportFile.Guid != "" {
+			return httpservice.ResponseData(ctx, payload.ToPayloadExportFile(exportFile, cfg.GetString(constants.ConfigPrefixRoutesBackoffice)), nil)
+		}
+
+		ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", exportRequest.FileName))
+
+		return ctx.Blob(http.StatusOK, utility.ExportContentType(request.Format), content)
+	}
+}
+
 func updateWarehouse(svc *service.WarehouseService) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		guid := ctx.Param("guid")
@@ -158,3 +276,280 @@ func getWarehouse(svc *service.WarehouseService) echo.HandlerFunc {
 		return httpservice.ResponseData(ctx, payload.ToPayloadWarehouse(data), nil)
 	}
 }
+
+func listWarehouseStock(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListStockBalancePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		if request.AsOf != "" {
+			return listWarehouseStockAsOf(ctx, svc, guid, request)
+		}
+
+		listData, totalData, err := svc.ListWarehouseStock(ctx.Request().Context(), request.ToEntityWarehouse(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListWarehouseStockBalance(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func createWarehouseLocation(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.RegisterWarehouseLocationPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.CreateWarehouseLocation(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadWarehouseLocation(data), nil)
+	}
+}
+
+func updateWarehouseLocation(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		locationGUID := ctx.Param("location_guid")
+		if guid == "" || locationGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.UpdateWarehouseLocationPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		data, err := svc.UpdateWarehouseLocation(ctx.Request().Context(), request.ToEntity(ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow), guid, locationGUID))
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadWarehouseLocation(data), nil)
+	}
+}
+
+func deleteWarehouseLocation(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		locationGUID := ctx.Param("location_guid")
+		if guid == "" || locationGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.DeleteWarehouseLocation(ctx.Request().Context(), guid, locationGUID, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func reactiveWarehouseLocation(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		locationGUID := ctx.Param("location_guid")
+		if guid == "" || locationGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		userData := ctx.Get(constants.MddwUserBackoffice).(sqlc.GetUserBackofficeRow)
+
+		err := svc.ReactiveWarehouseLocation(ctx.Request().Context(), guid, locationGUID, userData)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, nil, nil)
+	}
+}
+
+func listWarehouseLocation(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListWarehouseLocationPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListWarehouseLocation(ctx.Request().Context(), request.ToEntity(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListWarehouseLocation(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func getWarehouseLocation(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		locationGUID := ctx.Param("location_guid")
+		if guid == "" || locationGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		data, err := svc.GetWarehouseLocation(ctx.Request().Context(), guid, locationGUID)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadWarehouseLocation(data), nil)
+	}
+}
+
+// listWarehouseStockAsOf answers the stock query for a past point in time from the movement ledger.
+func listWarehouseStockAsOf(ctx echo.Context, svc *service.WarehouseService, guid string, request payload.ListStockBalancePayload) error {
+	requestAsOf := request.ToEntityWarehouseAsOf(guid)
+
+	listData, totalData, err := svc.ListWarehouseStockAsOf(ctx.Request().Context(), requestAsOf)
+	if err != nil {
+		return err
+	}
+
+	// TOTAL PAGE
+	totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+	return httpservice.ResponsePagination(ctx, payload.ToPayloadListWarehouseStockBalanceAsOf(guid, requestAsOf.AsOf, listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+}
+
+func listWarehouseLocationStock(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		locationGUID := ctx.Param("location_guid")
+		if guid == "" || locationGUID == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListStockBalancePayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListWarehouseLocationStock(ctx.Request().Context(), guid, request.ToEntityBin(locationGUID))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListBinStockLocationBalance(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
+
+func suggestWarehouseLot(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.SuggestStockLotPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listLot, allocation, err := svc.SuggestWarehouseLot(ctx.Request().Context(), request.ToEntity(guid), request.Quantity)
+		if err != nil {
+			return err
+		}
+
+		return httpservice.ResponseData(ctx, payload.ToPayloadStockLotSuggestion(request, guid, listLot, allocation), nil)
+	}
+}
+
+func listExpiringWarehouseLot(svc *service.WarehouseService) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		guid := ctx.Param("guid")
+		if guid == "" {
+			return errors.Wrap(httpservice.ErrBadRequest, httpservice.MsgInvalidIDParam)
+		}
+
+		var request payload.ListExpiringStockLotPayload
+		if err := ctx.Bind(&request); err != nil {
+			log.FromCtx(ctx.Request().Context()).Error(err, "failed to parse request")
+			return errors.WithStack(httpservice.ErrBadRequest)
+		}
+
+		// Validate request
+		if err := request.Validate(); err != nil {
+			return err
+		}
+
+		listData, totalData, err := svc.ListExpiringWarehouseLot(ctx.Request().Context(), request.ToEntity(guid))
+		if err != nil {
+			return err
+		}
+
+		// TOTAL PAGE
+		totalPage := math.Ceil(float64(totalData) / float64(request.Limit))
+
+		return httpservice.ResponsePagination(ctx, payload.ToPayloadListExpiringStockLot(listData), nil, int(request.Offset), int(request.Limit), int(totalPage), int(totalData))
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// parentLocationType lists which location type a zone, rack or bin must hang under.
+var parentLocationType = map[string]string{
+	constants.WarehouseLocationTypeRack: constants.WarehouseLocationTypeZone,
+	constants.WarehouseLocationTypeBin:  constants.WarehouseLocationTypeRack,
+}
+
+func (s *WarehouseService) CreateWarehouseLocation(ctx context.Context, request sqlc.InsertWarehouseLocationParams) (warehouseLocation sqlc.WarehouseLocation, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	warehouse, err := q.GetWarehouse(ctx, request.WarehouseGuid)
+	if err != nil || warehouse.DeletedAt.Valid {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	if request.ParentGuid.Valid {
+		if err = validateParentLocation(ctx, q, request.WarehouseGuid, request.ParentGuid.String, request.LocationType); err != nil {
+			return
+		}
+	}
+
+	warehouseLocation, err = q.InsertWarehouseLocation(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed insert warehouse location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func validateParentLocation(ctx context.Context, q *sqlc.Queries, warehouseGUID string, parentGUID string, locationType string) (err error) {
+	parent, err := q.GetWarehouseLocation(ctx, parentGUID)
+	if err != nil || parent.DeletedAt.Valid || parent.WarehouseGuid != warehouseGUID {
+		log.FromCtx(ctx).Error(err, "failed get parent warehouse location")
+		err = errors.WithStack(httpservice.ErrWarehouseLocationNotFound)
+
+		return
+	}
+
+	if parent.LocationType != parentLocationType[locationType] {
+		err = errors.Wrapf(httpservice.ErrBadRequest, "bad request: %s must be placed under a %s", locationType, parentLocationType[locationType])
+		return
+	}
+
+	return
+}
@@ -39,6 +39,26 @@ func (s *WarehouseService) CreateWarehouse(ctx context.Context, request sqlc.Ins
 		return
 	}
 
+	warehouse, err = createWarehouse(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func createWarehouse(ctx context.Context, q *sqlc.Queries, request sqlc.InsertWarehouseParams) (warehouse sqlc.Warehouse, err error) {
+	if err = validateWarehouseCode(ctx, q, request.WarehouseCode); err != nil {
+		return
+	}
+
 	warehouse, err = q.InsertWarehouse(ctx, request)
 	if err != nil {
 		log.FromCtx(ctx).Error(err, "failed insert warehouse")
@@ -47,12 +67,25 @@ func (s *WarehouseService) CreateWarehouse(ctx context.Context, request sqlc.Ins
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.FromCtx(ctx).Error(err, "error commit")
+	return
+}
+
+// validateWarehouseCode makes sure no warehouse, active or not, already uses the code.
+func validateWarehouseCode(ctx context.Context, q *sqlc.Queries, warehouseCode string) (err error) {
+	_, err = q.GetWarehouseByWarehouseCode(ctx, warehouseCode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil
+			return
+		}
+
+		log.FromCtx(ctx).Error(err, "failed get warehouse by warehouse code")
 		err = errors.WithStack(httpservice.ErrUnknownSource)
 
 		return
 	}
 
+	err = errors.Wrapf(httpservice.ErrDuplicateWarehouseCode, "warehouse code %s is already used", warehouseCode)
+
 	return
 }
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/constants"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// DeleteWarehouseLocation deactivates a location. Locations that still have active
+// children, or bins that still hold stock, have to be emptied first.
+func (s *WarehouseService) DeleteWarehouseLocation(ctx context.Context, warehouseGUID string, guid string, userData sqlc.GetUserBackofficeRow) (err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	warehouseLocation, err := getWarehouseLocation(ctx, q, warehouseGUID, guid)
+	if err != nil {
+		return
+	}
+
+	totalChild, err := q.GetCountActiveChildWarehouseLocation(ctx, sql.NullString{
+		String: guid,
+		Valid:  true,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total active child warehouse location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if totalChild > 0 {
+		err = errors.WithStack(httpservice.ErrWarehouseLocationInUse)
+		return
+	}
+
+	if warehouseLocation.LocationType == constants.WarehouseLocationTypeBin {
+		var totalStock int64
+
+		totalStock, err = q.GetCountStockLocationBalanceByBin(ctx, guid)
+		if err != nil {
+			log.FromCtx(ctx).Error(err, "failed get total data warehouse location stock")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if totalStock > 0 {
+			err = errors.WithStack(httpservice.ErrWarehouseLocationInUse)
+			return
+		}
+	}
+
+	if err = q.DeleteWarehouseLocation(ctx, sqlc.DeleteWarehouseLocationParams{
+		DeletedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid:          guid,
+		WarehouseGuid: warehouseGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed delete warehouse location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
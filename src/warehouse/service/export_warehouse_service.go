@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// GetCountWarehouse counts the rows an export of the list filter holds.
+func (s *WarehouseService) GetCountWarehouse(ctx context.Context, request sqlc.ListWarehouseParams) (totalData int64, err error) {
+	return s.getCountWarehouse(ctx, sqlc.New(s.mainDB), request)
+}
+
+// ExportWarehouse reads every warehouse matching the list filter, a page of request.LimitData rows
+// at a time, and hands each page to writePage.
+func (s *WarehouseService) ExportWarehouse(ctx context.Context, request sqlc.ListWarehouseParams, writePage func(listWarehouse []sqlc.ListWarehouseRow) error) (err error) {
+	q := sqlc.New(s.mainDB)
+
+	for request.OffsetPage = 0; ; request.OffsetPage += request.LimitData {
+		listWarehouse, errList := q.ListWarehouse(ctx, request)
+		if errList != nil {
+			log.FromCtx(ctx).Error(errList, "failed get list warehouse")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		if len(listWarehouse) > 0 {
+			if err = writePage(listWarehouse); err != nil {
+				return
+			}
+		}
+
+		if len(listWarehouse) < int(request.LimitData) {
+			return
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	"github.com/wit-id/blueprint-backend-go/common/utility"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ImportWarehouse creates the warehouses of an import file in a single transaction. A rejected
+// row is rolled back on its own and its reason returned at the same index of listError, the
+// valid rows are committed. A dry run checks every row the same way and then rolls back.
+func (s *WarehouseService) ImportWarehouse(ctx context.Context, listRequest []sqlc.InsertWarehouseParams, dryRun bool) (listError []error, err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	listError = make([]error, len(listRequest))
+
+	for i := range listRequest {
+		request := listRequest[i]
+
+		listError[i], err = utility.ImportInSavepoint(ctx, tx, func() (errRow error) {
+			_, errRow = createWarehouse(ctx, q, request)
+			return
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	if dryRun {
+		if err = tx.Rollback(); err != nil {
+			log.FromCtx(ctx).Error(err, "error rollback")
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
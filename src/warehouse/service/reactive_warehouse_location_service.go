@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+// ReactiveWarehouseLocation reactivates a location whose parent is still active.
+func (s *WarehouseService) ReactiveWarehouseLocation(ctx context.Context, warehouseGUID string, guid string, userData sqlc.GetUserBackofficeRow) (err error) {
+	tx, err := s.mainDB.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed begin tx")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	q := sqlc.New(s.mainDB).WithTx(tx)
+
+	defer func() {
+		if err != nil {
+			if rollBackErr := tx.Rollback(); rollBackErr != nil {
+				log.FromCtx(ctx).Error(err, "error rollback", rollBackErr)
+				err = errors.WithStack(httpservice.ErrUnknownSource)
+
+				return
+			}
+		}
+	}()
+
+	warehouseLocation, err := getWarehouseLocation(ctx, q, warehouseGUID, guid)
+	if err != nil {
+		return
+	}
+
+	if warehouseLocation.ParentGuid.Valid {
+		var parent sqlc.WarehouseLocation
+
+		parent, err = q.GetWarehouseLocation(ctx, warehouseLocation.ParentGuid.String)
+		if err != nil || parent.DeletedAt.Valid {
+			log.FromCtx(ctx).Error(err, "failed get parent warehouse location")
+			err = errors.Wrap(httpservice.ErrBadRequest, "bad request: parent location is not active")
+
+			return
+		}
+	}
+
+	if err = q.ReactiveWarehouseLocation(ctx, sqlc.ReactiveWarehouseLocationParams{
+		UpdatedBy: sql.NullString{
+			String: userData.Guid,
+			Valid:  true,
+		},
+		Guid:          guid,
+		WarehouseGuid: warehouseGUID,
+	}); err != nil {
+		log.FromCtx(ctx).Error(err, "failed reactive warehouse location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.FromCtx(ctx).Error(err, "error commit")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
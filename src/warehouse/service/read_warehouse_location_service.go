@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *WarehouseService) ListWarehouseLocation(ctx context.Context, request sqlc.ListWarehouseLocationParams) (listWarehouseLocation []sqlc.WarehouseLocation, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	// Get Total data
+	totalData, err = s.getCountWarehouseLocation(ctx, q, request)
+	if err != nil {
+		return
+	}
+
+	listWarehouseLocation, err = q.ListWarehouseLocation(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list warehouse location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+func (s *WarehouseService) GetWarehouseLocation(ctx context.Context, warehouseGUID string, guid string) (warehouseLocation sqlc.WarehouseLocation, err error) {
+	q := sqlc.New(s.mainDB)
+
+	warehouseLocation, err = getWarehouseLocation(ctx, q, warehouseGUID, guid)
+
+	return
+}
+
+func (s *WarehouseService) ListWarehouseLocationStock(ctx context.Context, warehouseGUID string, request sqlc.ListStockLocationBalanceByBinParams) (listStock []sqlc.ListStockLocationBalanceByBinRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = getWarehouseLocation(ctx, q, warehouseGUID, request.BinGuid); err != nil {
+		return
+	}
+
+	totalData, err = q.GetCountStockLocationBalanceByBin(ctx, request.BinGuid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data warehouse location stock")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listStock, err = q.ListStockLocationBalanceByBin(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list warehouse location stock")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// getWarehouseLocation loads a location and makes sure it belongs to the warehouse in the route.
+func getWarehouseLocation(ctx context.Context, q *sqlc.Queries, warehouseGUID string, guid string) (warehouseLocation sqlc.WarehouseLocation, err error) {
+	warehouseLocation, err = q.GetWarehouseLocation(ctx, guid)
+	if err != nil || warehouseLocation.WarehouseGuid != warehouseGUID {
+		log.FromCtx(ctx).Error(err, "failed get warehouse location")
+		err = errors.WithStack(httpservice.ErrWarehouseLocationNotFound)
+
+		return
+	}
+
+	return
+}
+
+func (s *WarehouseService) getCountWarehouseLocation(ctx context.Context, q *sqlc.Queries, request sqlc.ListWarehouseLocationParams) (totalData int64, err error) {
+	requestQueryParams := sqlc.GetCountWarehouseLocationParams{
+		WarehouseGuid:   request.WarehouseGuid,
+		SetLocationType: request.SetLocationType,
+		LocationType:    request.LocationType,
+		SetParent:       request.SetParent,
+		ParentGuid:      request.ParentGuid,
+		SetActive:       request.SetActive,
+		Active:          request.Active,
+	}
+
+	totalData, err = q.GetCountWarehouseLocation(ctx, requestQueryParams)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data list warehouse location")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
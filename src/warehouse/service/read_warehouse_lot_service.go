@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	productHistoryService "github.com/wit-id/blueprint-backend-go/src/product_history/service"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"time"
+)
+
+// SuggestWarehouseLot lists the lots a picker should take a quantity from, earliest expiry first.
+func (s *WarehouseService) SuggestWarehouseLot(ctx context.Context, request sqlc.ListStockLotBalanceFEFOParams, quantity int64) (listLot []sqlc.StockLotBalance, allocation []sqlc.StockLotBalance, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	listLot, err = q.ListStockLotBalanceFEFO(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list stock lot balance")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	allocation = productHistoryService.AllocateLotFEFO(listLot, quantity, time.Now())
+
+	return
+}
+
+func (s *WarehouseService) ListExpiringWarehouseLot(ctx context.Context, request sqlc.ListExpiringStockLotParams) (listLot []sqlc.ListExpiringStockLotRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	totalData, err = q.GetCountExpiringStockLot(ctx, sqlc.GetCountExpiringStockLotParams{
+		WarehouseGuid: request.WarehouseGuid,
+		ExpiryBefore:  request.ExpiryBefore,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data expiring stock lot")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listLot, err = q.ListExpiringStockLot(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list expiring stock lot")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
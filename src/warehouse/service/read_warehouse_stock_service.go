@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+func (s *WarehouseService) ListWarehouseStock(ctx context.Context, request sqlc.ListStockBalanceByWarehouseParams) (listStock []sqlc.ListStockBalanceByWarehouseRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	totalData, err = q.GetCountStockBalanceByWarehouse(ctx, request.WarehouseGuid)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data warehouse stock")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listStock, err = q.ListStockBalanceByWarehouse(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list warehouse stock")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/wit-id/blueprint-backend-go/common/httpservice"
+	sqlc "github.com/wit-id/blueprint-backend-go/src/repository/pgbo_sqlc"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+	"time"
+)
+
+// A stock snapshot of a date holds the balance of every product and warehouse at the end of
+// that day in UTC. Point in time queries start from the latest snapshot before the requested
+// time and only replay the movements booked after it.
+
+// ListWarehouseStockAsOf rebuilds the stock a warehouse held at the given time from the latest
+// snapshot and the movement ledger. Products without stock at that time are left out.
+func (s *WarehouseService) ListWarehouseStockAsOf(ctx context.Context, request sqlc.ListStockBalanceAsOfParams) (listStock []sqlc.ListStockBalanceAsOfRow, totalData int64, err error) {
+	q := sqlc.New(s.mainDB)
+
+	if _, err = q.GetWarehouse(ctx, request.WarehouseGuid); err != nil {
+		log.FromCtx(ctx).Error(err, "failed get warehouse")
+		err = errors.WithStack(httpservice.ErrWarehouseNotFound)
+
+		return
+	}
+
+	// Only a day that ended by the requested time can be used
+	snapshotDate, err := q.GetLatestStockSnapshotDate(ctx, startOfDay(request.AsOf.Add(time.Microsecond)).AddDate(0, 0, -1))
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get latest stock snapshot date")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	request.SnapshotDate, request.StartTime = snapshotDate, snapshotEnd(snapshotDate)
+
+	totalData, err = q.GetCountStockBalanceAsOf(ctx, sqlc.GetCountStockBalanceAsOfParams{
+		WarehouseGuid: request.WarehouseGuid,
+		SnapshotDate:  request.SnapshotDate,
+		StartTime:     request.StartTime,
+		AsOf:          request.AsOf,
+	})
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get total data warehouse stock as of")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	listStock, err = q.ListStockBalanceAsOf(ctx, request)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get list warehouse stock as of")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	return
+}
+
+// TakeStockSnapshot snapshots every day up to yesterday that has no snapshot yet, each rolled
+// forward from the day before. The first run sums the whole ledger into yesterday's snapshot.
+func (s *WarehouseService) TakeStockSnapshot(ctx context.Context) (listDate []time.Time, err error) {
+	q := sqlc.New(s.mainDB)
+
+	target := startOfDay(time.Now().UTC()).AddDate(0, 0, -1)
+
+	previous, err := q.GetLatestStockSnapshotDate(ctx, target)
+	if err != nil {
+		log.FromCtx(ctx).Error(err, "failed get latest stock snapshot date")
+		err = errors.WithStack(httpservice.ErrUnknownSource)
+
+		return
+	}
+
+	date := target
+	if !previous.IsZero() {
+		date = previous.AddDate(0, 0, 1)
+	}
+
+	for ; !date.After(target); date = date.AddDate(0, 0, 1) {
+		if err = q.InsertStockSnapshot(ctx, sqlc.InsertStockSnapshotParams{
+			SnapshotDate:         date,
+			PreviousSnapshotDate: previous,
+			StartTime:            snapshotEnd(previous),
+			EndTime:              snapshotEnd(date),
+		}); err != nil {
+			log.FromCtx(ctx).Error(err, "failed insert stock snapshot", "snapshot_date", date)
+			err = errors.WithStack(httpservice.ErrUnknownSource)
+
+			return
+		}
+
+		listDate = append(listDate, date)
+		previous = date
+	}
+
+	return
+}
+
+// snapshotEnd is the first moment not covered by the snapshot of a date. The zero date means
+// no snapshot, every movement is replayed.
+func snapshotEnd(date time.Time) time.Time {
+	if date.IsZero() {
+		return date
+	}
+
+	return date.AddDate(0, 0, 1)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
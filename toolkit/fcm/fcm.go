@@ -0,0 +1,71 @@
+// Package fcm sends push notifications through Firebase Cloud Messaging.
+package fcm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/wit-id/blueprint-backend-go/toolkit/config"
+	"github.com/wit-id/blueprint-backend-go/toolkit/log"
+)
+
+var errInvalidConfig = errors.New("fcm: invalid config")
+
+// Message is the notification delivered to a single device token.
+type Message struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// Sender delivers a message to one device. Implementations must be safe for concurrent use.
+type Sender interface {
+	Send(ctx context.Context, token string, msg Message) error
+}
+
+// NewFromConfig returns the FCM HTTP v1 sender when `<path>.enabled` is set, otherwise a sender
+// that only logs the messages so local environments do not need firebase credentials.
+func NewFromConfig(cfg config.KVStore, path string) (Sender, error) {
+	if !cfg.GetBool(fmt.Sprintf("%s.enabled", path)) {
+		return NewLogSender(), nil
+	}
+
+	projectID := cfg.GetString(fmt.Sprintf("%s.project-id", path))
+	if projectID == "" {
+		return nil, errors.Wrap(errInvalidConfig, "project-id is required")
+	}
+
+	tokenSource, err := NewServiceAccountTokenSource(cfg.GetString(fmt.Sprintf("%s.credentials-file", path)))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewHTTPSender(projectID, tokenSource), nil
+}
+
+type logSender struct{}
+
+// NewLogSender returns a Sender that writes every message to the logger instead of sending it.
+func NewLogSender() Sender {
+	return logSender{}
+}
+
+func (logSender) Send(ctx context.Context, token string, msg Message) error {
+	log.FromCtx(ctx).Info("fcm message (not sent)", "token", maskToken(token), "title", msg.Title, "body", msg.Body, "data", msg.Data)
+
+	return nil
+}
+
+// maskToken keeps only the last characters of a device token, enough to tell devices apart in
+// the log without handing out a token that can be pushed to.
+func maskToken(token string) string {
+	const visible = 6
+
+	if len(token) <= visible {
+		return "***"
+	}
+
+	return "***" + token[len(token)-visible:]
+}
@@ -0,0 +1,41 @@
+package fcm
+
+import (
+	"context"
+	"sync"
+)
+
+// SentMessage is a message recorded by FakeSender.
+type SentMessage struct {
+	Token   string
+	Message Message
+}
+
+// FakeSender records every message in memory instead of sending it. Set Err to make Send fail.
+type FakeSender struct {
+	Err error
+
+	mu   sync.Mutex
+	sent []SentMessage
+}
+
+func (f *FakeSender) Send(_ context.Context, token string, msg Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.Err != nil {
+		return f.Err
+	}
+
+	f.sent = append(f.sent, SentMessage{Token: token, Message: msg})
+
+	return nil
+}
+
+// Sent returns a copy of the recorded messages.
+func (f *FakeSender) Sent() []SentMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]SentMessage(nil), f.sent...)
+}
@@ -0,0 +1,98 @@
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/wit-id/blueprint-backend-go/toolkit/web/httpclient"
+)
+
+const (
+	defaultEndpoint = "https://fcm.googleapis.com/v1/projects/%s/messages:send"
+	defaultTimeout  = 10 * time.Second
+)
+
+var errSendFailed = errors.New("fcm: send failed")
+
+// TokenSource provides the OAuth2 access token used to authorize FCM requests.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type httpSender struct {
+	endpoint    string
+	tokenSource TokenSource
+	client      *http.Client
+}
+
+type sendRequest struct {
+	Message sendMessage `json:"message"`
+}
+
+type sendMessage struct {
+	Token        string            `json:"token"`
+	Notification sendNotification  `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type sendNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+// NewHTTPSender returns a Sender calling the FCM HTTP v1 API of the given firebase project.
+func NewHTTPSender(projectID string, tokenSource TokenSource) Sender {
+	return &httpSender{
+		endpoint:    fmt.Sprintf(defaultEndpoint, projectID),
+		tokenSource: tokenSource,
+		client:      httpclient.NewStdHTTPClient(httpclient.WithTimeout(defaultTimeout)),
+	}
+}
+
+func (s *httpSender) Send(ctx context.Context, token string, msg Message) error {
+	accessToken, err := s.tokenSource.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(sendRequest{
+		Message: sendMessage{
+			Token:        token,
+			Notification: sendNotification{Title: msg.Title, Body: msg.Body},
+			Data:         msg.Data,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "fcm: marshal message")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "fcm: build request")
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "fcm: send request")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+		return errors.Wrapf(errSendFailed, "status=%d body=%s", resp.StatusCode, respBody)
+	}
+
+	return nil
+}
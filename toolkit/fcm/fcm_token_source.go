@@ -0,0 +1,136 @@
+package fcm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/wit-id/blueprint-backend-go/toolkit/web/httpclient"
+)
+
+const (
+	messagingScope  = "https://www.googleapis.com/auth/firebase.messaging"
+	defaultTokenURI = "https://oauth2.googleapis.com/token"
+	// refresh the access token a bit before google expires it.
+	tokenExpiryLeeway = time.Minute
+)
+
+var errTokenFailed = errors.New("fcm: failed to obtain access token")
+
+type serviceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type serviceAccountTokenSource struct {
+	account serviceAccount
+	client  *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewServiceAccountTokenSource reads a google service account json key and exchanges a signed
+// jwt assertion for an access token, caching it until shortly before it expires.
+func NewServiceAccountTokenSource(credentialsFile string) (TokenSource, error) {
+	if credentialsFile == "" {
+		return nil, errors.Wrap(errInvalidConfig, "credentials-file is required")
+	}
+
+	raw, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "fcm: read credentials file")
+	}
+
+	var account serviceAccount
+	if err = json.Unmarshal(raw, &account); err != nil {
+		return nil, errors.Wrap(err, "fcm: parse credentials file")
+	}
+
+	if account.ClientEmail == "" || account.PrivateKey == "" {
+		return nil, errors.Wrap(errInvalidConfig, "credentials file is not a service account key")
+	}
+
+	if account.TokenURI == "" {
+		account.TokenURI = defaultTokenURI
+	}
+
+	return &serviceAccountTokenSource{
+		account: account,
+		client:  httpclient.NewStdHTTPClient(httpclient.WithTimeout(defaultTimeout)),
+	}, nil
+}
+
+func (ts *serviceAccountTokenSource) Token(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.accessToken != "" && time.Now().Before(ts.expiresAt) {
+		return ts.accessToken, nil
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(ts.account.PrivateKey))
+	if err != nil {
+		return "", errors.Wrap(err, "fcm: parse private key")
+	}
+
+	now := time.Now()
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   ts.account.ClientEmail,
+		"scope": messagingScope,
+		"aud":   ts.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(key)
+	if err != nil {
+		return "", errors.Wrap(err, "fcm: sign assertion")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ts.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "fcm: build token request")
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := ts.client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "fcm: token request")
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Wrapf(errTokenFailed, "status=%d", resp.StatusCode)
+	}
+
+	var token tokenResponse
+	if err = json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", errors.Wrap(err, "fcm: decode token response")
+	}
+
+	ts.accessToken = token.AccessToken
+	ts.expiresAt = now.Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpiryLeeway)
+
+	return ts.accessToken, nil
+}